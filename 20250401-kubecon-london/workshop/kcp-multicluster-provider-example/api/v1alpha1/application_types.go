@@ -31,16 +31,151 @@ type ApplicationSpec struct {
 	DatabaseRef string `json:"databaseRef,omitempty"`
 
 	DatabaseSecretRef corev1.SecretReference `json:"databaseSecretRef,omitempty"`
+
+	// DeletionPolicy controls what happens to the provider objects when the
+	// Application is deleted. Delete removes them, Orphan leaves them running
+	// and only drops the controller's management labels.
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Ingress, if set, exposes the Application's Service on the provider
+	// through an Ingress.
+	// +optional
+	Ingress *ApplicationIngress `json:"ingress,omitempty"`
+}
+
+// ApplicationIngress describes the Ingress an Application is exposed through.
+type ApplicationIngress struct {
+	// Host is the host name the Application is served on.
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+	// ClassName is the IngressClass of the Ingress. The cluster default is
+	// used if it is empty.
+	// +optional
+	ClassName string `json:"className,omitempty"`
+	// TLSSecretName, if set, terminates TLS for Host with the certificate
+	// in this Secret of the provider namespace.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// DeletionPolicy describes what happens to the provider objects of a deleted
+// Application.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the provider objects with the Application.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan keeps the provider objects running.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+const (
+	// ConditionReady reports whether the Application has been provisioned
+	// on the provider.
+	ConditionReady = "Ready"
+
+	// ConditionClusterReady mirrors the Ready condition of the CNPG Cluster
+	// backing the Application's database.
+	ConditionClusterReady = "ClusterReady"
+
+	// ConditionWaitingForSecret is set while the database secret the
+	// Application refers to does not exist yet.
+	ConditionWaitingForSecret = "WaitingForSecret"
+)
+
+const (
+	// MilestoneProviderObjectsCreated is reached when the provider objects
+	// of the Application were created.
+	MilestoneProviderObjectsCreated = "ProviderObjectsCreated"
+	// MilestoneClusterReady is reached when the CNPG Cluster backing the
+	// Application's database became ready.
+	MilestoneClusterReady = "ClusterReady"
+	// MilestoneReady is reached when the Application became Ready.
+	MilestoneReady = "Ready"
+
+	// MaxTimelineEvents is the number of milestones kept on the timeline.
+	MaxTimelineEvents = 16
+)
+
+// TimelineEvent is a provisioning milestone of an Application.
+type TimelineEvent struct {
+	// Milestone is the milestone that was reached.
+	Milestone string `json:"milestone"`
+	// Time is when the milestone was reached.
+	Time metav1.Time `json:"time"`
+}
+
+// MaxFailureHistory is the number of failures kept in the failure history.
+const MaxFailureHistory = 10
+
+// FailureEvent is a failure of an Application.
+type FailureEvent struct {
+	// Reason is the reason the Application failed for.
+	Reason string `json:"reason"`
+	// Message is the human readable description of the failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Time is when the Application failed.
+	Time metav1.Time `json:"time"`
 }
 
 // ApplicationStatus defines the observed state of Application.
 type ApplicationStatus struct {
 	Status           string `json:"status,omitempty"`
 	ConnectionString string `json:"connectionString,omitempty"`
+
+	// ObservedSpecHash is the hash of the spec that was last provisioned
+	// successfully.
+	// +optional
+	ObservedSpecHash string `json:"observedSpecHash,omitempty"`
+
+	// ObservedForceReconcile is the value of the force-reconcile annotation
+	// the Application was last provisioned with.
+	// +optional
+	ObservedForceReconcile string `json:"observedForceReconcile,omitempty"`
+
+	// LastReconcileTime is when the Application was last reconciled
+	// successfully. While nothing else changes it is only refreshed every
+	// few minutes, so it is a staleness signal rather than a precise clock.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// ClusterPhase is the phase of the CNPG Cluster backing the
+	// Application's database, as reported by the provider.
+	// +optional
+	ClusterPhase string `json:"clusterPhase,omitempty"`
+
+	// Conditions represent the latest available observations of the
+	// Application's state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Timeline records the provisioning milestones of the Application,
+	// oldest first. Only the most recent milestones are kept.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Timeline []TimelineEvent `json:"timeline,omitempty"`
+
+	// FailureHistory records the most recent failures of the Application,
+	// oldest first, to tell a flapping Application from a consistently
+	// failing one. It is cleared once the Application stayed Ready for a
+	// while.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	FailureHistory []FailureEvent `json:"failureHistory,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=app;apps-db,categories=kcp
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Endpoint",type=string,JSONPath=`.status.connectionString`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Application is the Schema for the applications API.
 type Application struct {
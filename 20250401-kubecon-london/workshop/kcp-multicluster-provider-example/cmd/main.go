@@ -21,8 +21,12 @@ import (
 	"crypto/tls"
 	"flag"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -35,26 +39,47 @@ import (
 	"github.com/kcp-dev/multicluster-provider/virtualworkspace"
 	mcbuilder "github.com/multicluster-runtime/multicluster-runtime/pkg/builder"
 	mcmanager "github.com/multicluster-runtime/multicluster-runtime/pkg/manager"
+	"github.com/multicluster-runtime/multicluster-runtime/pkg/multicluster"
 	mcreconcile "github.com/multicluster-runtime/multicluster-runtime/pkg/reconcile"
 
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	crcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
 
 	applicationapisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/admin"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/audit"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/config"
 	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/controller"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/debounce"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/featuregate"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/inventory"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/leader"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/progress"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/providerclient"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/resync"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/selftest"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/supervisor"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/throttle"
+	webhookapisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/internal/webhook/v1alpha1"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/webhookcert"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -74,37 +99,216 @@ func init() {
 
 // nolint:gocyclo
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "render" {
+		if err := runRender(os.Args[2:], os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
-	var webhookCertPath, webhookCertName, webhookCertKey string
+	var webhookCertPath, webhookCertName, webhookCertKey, webhookCAName string
+	var manageWebhookConfig bool
+	var validatingWebhookConfig, mutatingWebhookConfig string
 	var enableLeaderElection bool
+	var leaderElectionReleaseOnCancel bool
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var tlsMinVersion, tlsCipherSuites string
 	var server string
 	var providerKubeConfig string
+	var singleCluster bool
+	var providerHealthCheckInterval time.Duration
+	var providerCache bool
+	var providerMaxRestarts int
+	var auditLogPath string
+	var configFile string
+	var maxConcurrentReconciles int
+	var reconcileDebounce time.Duration
+	var throttleRecoveryInterval time.Duration
+	var stuckReconcileWindow time.Duration
+	var gracefulShutdownTimeout time.Duration
+	var qps, providerQPS float64
+	var burst, providerBurst int
+	var observeOnly bool
+	var approval controller.ApprovalSource
+	var requiredAnnotation string
+	var requiredLabels string
+	var naming controller.ProviderNaming
+	var defaultProviderNamespace string
+	var postCreateRequeueDelay time.Duration
+	var maxRequeueInterval time.Duration
+	var maxTotalApplications int
+	var verifyConnectivity bool
+	var propagateServerCA bool
+	var coalesceStatusUpdates bool
+	var discoveryConfigMap string
+	var metricsWorkspaceLabel bool
+	var metricsMaxWorkspaces int
+	var enableWebhooks bool
+	var namePattern string
+	var admissionPreCheck bool
+	var rejectNameCollisions bool
+	var adminAddr string
+	var secureAdmin bool
+	var adminCertPath, adminCertName, adminCertKey string
+	var runSelftest bool
+	var printDashboard bool
+	var selftestKubeConfig string
+	var selftestOpts selftest.Options
 	var tlsOpts []func(*tls.Config)
+	flag.StringVar(&configFile, "config", "",
+		"The path to a YAML file with the controller configuration. Flags set on the command line take precedence.")
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"The maximum number of Applications reconciled concurrently.")
+	flag.DurationVar(&throttleRecoveryInterval, "throttle-recovery-interval", 0,
+		"If set, reconcile concurrency is halved whenever the provider responds with 429 Too Many Requests "+
+			"and grows back by one per interval without throttling.")
+	flag.DurationVar(&reconcileDebounce, "reconcile-debounce", time.Second,
+		"Events for the same Application within this window are coalesced into a single reconcile. 0 disables debouncing.")
+	flag.DurationVar(&stuckReconcileWindow, "stuck-reconcile-window", 10*time.Minute,
+		"The health check fails if no reconcile completed within this window while reconciles are in flight. 0 disables the check.")
+	flag.DurationVar(&gracefulShutdownTimeout, "graceful-shutdown-timeout", 30*time.Second,
+		"How long the manager waits for its runnables to stop on shutdown.")
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&leaderElectionReleaseOnCancel, "leader-election-release-on-cancel", false,
+		"If set, the leader steps down when the manager stops instead of letting its lease expire, which speeds up "+
+			"failover during rolling updates. Only safe because the binary exits as soon as the manager stops.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
+	flag.StringVar(&namePattern, "name-pattern", "",
+		"If set, the webhook rejects Applications whose name does not fully match this regular expression.")
+	flag.BoolVar(&admissionPreCheck, "admission-precheck", false,
+		"If set, the webhook rejects new Applications whose provider namespace is missing or out of quota.")
+	flag.BoolVar(&rejectNameCollisions, "reject-name-collisions", false,
+		"If set, the webhook rejects new Applications whose provider objects would get the names of an existing Application.")
+	flag.StringVar(&webhookCAName, "webhook-ca-name", "ca.crt", "The name of the webhook CA bundle file.")
+	flag.BoolVar(&manageWebhookConfig, "manage-webhook-config", false,
+		"If set, the caBundle of the webhook configurations is kept in sync with the webhook certificate.")
+	flag.StringVar(&validatingWebhookConfig, "validating-webhook-config", "crd-validating-webhook-configuration",
+		"The name of the ValidatingWebhookConfiguration managed with --manage-webhook-config.")
+	flag.StringVar(&mutatingWebhookConfig, "mutating-webhook-config", "",
+		"The name of the MutatingWebhookConfiguration managed with --manage-webhook-config. "+
+			"Leave empty if no mutating webhook is deployed.")
 	flag.StringVar(&metricsCertPath, "metrics-cert-path", "",
 		"The directory that contains the metrics server certificate.")
 	flag.StringVar(&metricsCertName, "metrics-cert-name", "tls.crt", "The name of the metrics server certificate file.")
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&tlsMinVersion, "tls-min-version", "",
+		"The minimum TLS version of the metrics, webhook and admin servers, e.g. VersionTLS13. Defaults to the Go default.")
+	flag.StringVar(&tlsCipherSuites, "tls-cipher-suites", "",
+		"Comma-separated TLS 1.2 cipher suites of the metrics, webhook and admin servers, by Go name. "+
+			"Defaults to the Go default. TLS 1.3 cipher suites are not configurable.")
 	// MULTICLUSTER: This is where it differ from the default scaffold.
 	flag.StringVar(&server, "server", "", "Override for kubeconfig server URL")
 
-	flag.StringVar(&providerKubeConfig, "provider-kubeconfig", "", "The path to the kubeconfig file for the provider cluster.")
+	flag.StringVar(&providerKubeConfig, "provider-kubeconfig", "", "The path to the kubeconfig file for the provider cluster. "+
+		"Defaults to the local cluster with --single-cluster.")
+	flag.BoolVar(&singleCluster, "single-cluster", false,
+		"If set, Applications are reconciled in the cluster of the kubeconfig instead of in kcp workspaces. "+
+			"Applications are provisioned into their own namespace unless --default-provider-namespace is set.")
+	flag.DurationVar(&providerHealthCheckInterval, "provider-health-check-interval", time.Minute,
+		"How often the provider connection is checked. The provider client is rebuilt from the kubeconfig if the check fails. "+
+			"0 disables the check.")
+	flag.BoolVar(&providerCache, "provider-cache", false,
+		"If set, reads from the provider cluster are served from an informer cache, which needs list and watch "+
+			"permissions on the provider. Writes, Namespaces and Secrets always go to the provider API.")
+	flag.IntVar(&providerMaxRestarts, "provider-max-restarts", supervisor.DefaultOptions.MaxConsecutiveFailures,
+		"How many times in a row the multicluster provider is restarted after failing before the manager exits.")
+	flag.StringVar(&auditLogPath, "audit-log-path", "",
+		"If set, every create, update, patch and delete issued against the provider cluster "+
+			"is appended to this file as a JSON line.")
+	flag.Float64Var(&qps, "kube-api-qps", 0,
+		"The QPS limit of the client talking to kcp. Leave as 0 to use the client-go default.")
+	flag.IntVar(&burst, "kube-api-burst", 0,
+		"The burst limit of the client talking to kcp. Leave as 0 to use the client-go default.")
+	flag.Float64Var(&providerQPS, "provider-qps", 0,
+		"The QPS limit of the provider cluster client. Leave as 0 to use the client-go default.")
+	flag.IntVar(&providerBurst, "provider-burst", 0,
+		"The burst limit of the provider cluster client. Leave as 0 to use the client-go default.")
+	flag.StringVar(&adminAddr, "admin-bind-address", "0", "The address the admin API binds to. "+
+		"Only the leader serves it. Leave as 0 to disable the admin API.")
+	flag.BoolVar(&secureAdmin, "admin-secure", true,
+		"If set, the admin API is served via HTTPS and protected with authn/authz. "+
+			"Use --admin-secure=false to use HTTP instead.")
+	flag.StringVar(&adminCertPath, "admin-cert-path", "", "The directory that contains the admin API certificate.")
+	flag.StringVar(&adminCertName, "admin-cert-name", "tls.crt", "The name of the admin API certificate file.")
+	flag.StringVar(&adminCertKey, "admin-cert-key", "tls.key", "The name of the admin API key file.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the Application admission webhooks are served by the webhook server.")
+	flag.BoolVar(&metricsWorkspaceLabel, "metrics-workspace-label", false,
+		"If set, the Application reconcile metrics are labelled by workspace.")
+	flag.IntVar(&metricsMaxWorkspaces, "metrics-max-workspaces", 500,
+		"The maximum number of distinct workspace labels, further workspaces are reported as \"other\".")
+	flag.Var(featuregate.DefaultFeatureGate, "feature-gates",
+		"A set of key=value pairs that describe feature gates for experimental features. Options are:\n"+
+			strings.Join(featuregate.DefaultFeatureGate.KnownFeatures(), "\n"))
+	flag.BoolVar(&observeOnly, "observe-only", false,
+		"If set, Applications are only reported on and no writes are ever issued against the provider cluster.")
+	flag.StringVar(&approval.ConfigMapName, "approval-configmap", "",
+		"If set, Applications are only provisioned once a ConfigMap with this name exists in their namespace.")
+	flag.StringVar(&approval.Key, "approval-configmap-key", "",
+		"If set, the approval ConfigMap must additionally have this key set to \"true\".")
+	flag.StringVar(&requiredAnnotation, "required-annotation", "",
+		"If set, only Applications with this annotation are reconciled. Either a key, for any value, or key=value.")
+	flag.StringVar(&requiredLabels, "required-labels", "",
+		"Comma-separated labels every Application must carry, e.g. team,cost-center. "+
+			"Enforced by the webhook and copied onto the provider objects.")
+	flag.StringVar(&defaultProviderNamespace, "default-provider-namespace", "",
+		"If set, the provider objects of all Applications are created in this namespace, with names made unique per workspace.")
+	flag.StringVar(&naming.Prefix, "provider-name-prefix", "",
+		"The prefix of the provider object names. Names longer than 63 characters are truncated and suffixed with a hash.")
+	flag.StringVar(&naming.Suffix, "provider-name-suffix", "",
+		"The suffix of the provider object names. Names longer than 63 characters are truncated and suffixed with a hash.")
+	flag.DurationVar(&postCreateRequeueDelay, "post-create-requeue-delay", 5*time.Second,
+		"How long to wait before checking an Application again after its provider objects were created.")
+	flag.DurationVar(&maxRequeueInterval, "max-requeue-interval", 0,
+		"If set, caps the backoff of Applications whose reconcile failed and any other requeue delay. "+
+			"Leave as 0 to use the controller-runtime default backoff.")
+	flag.IntVar(&maxTotalApplications, "max-total-applications", 0,
+		"If set, new Applications are not provisioned once this many Applications are provisioned across all workspaces.")
+	flag.BoolVar(&verifyConnectivity, "verify-connectivity", false,
+		"If set, Applications are only marked Ready once the controller can open a TCP connection to their database.")
+	flag.BoolVar(&propagateServerCA, "propagate-server-ca", false,
+		"If set, the CA of each database server certificate is copied into a <name>-ca Secret next to the Application.")
+	flag.BoolVar(&coalesceStatusUpdates, "coalesce-status-updates", false,
+		"If set, Application status updates that change nothing are skipped and the last reconcile time is refreshed "+
+			"only every few minutes.")
+	flag.StringVar(&discoveryConfigMap, "discovery-configmap", "",
+		"If set, the endpoints of every Ready Application are published to this provider ConfigMap, given as namespace/name.")
+	flag.BoolVar(&printDashboard, "print-dashboard", false,
+		"If set, print a Grafana dashboard for the controller's metrics to stdout and exit.")
+	flag.BoolVar(&runSelftest, "selftest", false,
+		"If set, provision a canary Application on startup, wait for it to become Ready, delete it, "+
+			"wait for it to be finalized and exit.")
+	flag.StringVar(&selftestKubeConfig, "selftest-kubeconfig", "",
+		"The path to the kubeconfig file for the workspace the canary Application is created in.")
+	flag.StringVar(&selftestOpts.Namespace, "selftest-namespace", "default",
+		"The namespace the canary Application is created in.")
+	flag.StringVar(&selftestOpts.Name, "selftest-name", "selftest-canary", "The name of the canary Application.")
+	flag.StringVar(&selftestOpts.DatabaseRef, "selftest-database-ref", "",
+		"The provider Database the canary Application points at.")
+	flag.StringVar(&selftestOpts.DatabaseSecretName, "selftest-database-secret", "",
+		"The secret holding the database credentials for the canary Application.")
+	flag.DurationVar(&selftestOpts.Timeout, "selftest-timeout", 5*time.Minute,
+		"How long to wait for the canary Application to become Ready.")
+	flag.DurationVar(&selftestOpts.PollInterval, "selftest-poll-interval", 5*time.Second,
+		"How often to check the status of the canary Application.")
+	flag.StringVar(&selftestOpts.ConnectAddress, "selftest-connect-address", "",
+		"If set, the self-test opens a TCP connection to this host:port once the canary Application is Ready.")
 
 	opts := zap.Options{
 		Development: true,
@@ -114,6 +318,41 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if printDashboard {
+		dashboard, err := domainmetrics.Dashboard("kcp Applications")
+		if err != nil {
+			setupLog.Error(err, "unable to generate dashboard")
+			os.Exit(1)
+		}
+		fmt.Println(string(dashboard))
+		return
+	}
+
+	if configFile != "" {
+		controllerConfig, err := config.Load(configFile)
+		if err != nil {
+			setupLog.Error(err, "unable to load config file")
+			os.Exit(1)
+		}
+		if err := config.Apply(flag.CommandLine, controllerConfig); err != nil {
+			setupLog.Error(err, "unable to apply config file")
+			os.Exit(1)
+		}
+	}
+	if defaultProviderNamespace != "" {
+		naming.Unique = true
+	}
+	if runSelftest {
+		if selftestKubeConfig == "" {
+			setupLog.Error(nil, "--selftest-kubeconfig is required with --selftest")
+			os.Exit(1)
+		}
+		if selftestOpts.DatabaseRef == "" {
+			setupLog.Error(nil, "--selftest-database-ref is required with --selftest")
+			os.Exit(1)
+		}
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -129,6 +368,19 @@ func main() {
 		tlsOpts = append(tlsOpts, disableHTTP2)
 	}
 
+	if tlsMinVersion != "" || tlsCipherSuites != "" {
+		var cipherSuites []string
+		if tlsCipherSuites != "" {
+			cipherSuites = strings.Split(tlsCipherSuites, ",")
+		}
+		tlsSecurity, err := tlsSecurityOpts(tlsMinVersion, cipherSuites)
+		if err != nil {
+			setupLog.Error(err, "invalid TLS configuration")
+			os.Exit(1)
+		}
+		tlsOpts = append(tlsOpts, tlsSecurity)
+	}
+
 	// Create watchers for metrics and webhooks certificates
 	var metricsCertWatcher, webhookCertWatcher *certwatcher.CertWatcher
 
@@ -211,125 +463,495 @@ func main() {
 	if server != "" {
 		cfg.Host = server
 	}
+	setRateLimits(cfg, qps, burst)
+
+	// In kcp the Application API comes from the APIResourceSchema, which has
+	// the status subresource. A CRD installed for --single-cluster might not.
+	var noStatusSubresource bool
+	if singleCluster {
+		dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+		if err != nil {
+			setupLog.Error(err, "unable to create discovery client")
+			os.Exit(1)
+		}
+		enabled, err := controller.DetectStatusSubresource(dc)
+		if err != nil {
+			setupLog.Error(err, "unable to check the Application CRD")
+			os.Exit(1)
+		}
+		if !enabled {
+			setupLog.Info("The Application CRD has no status subresource, falling back to full updates for status. " +
+				"Enable the subresource to keep status writes separate from spec changes. " +
+				"Until then, the observedGeneration of the conditions trails the generation by one.")
+			noStatusSubresource = true
+		}
+	}
 
+	// Without a provider, the multicluster manager and builder fall back to
+	// the local cluster, which is what --single-cluster runs against.
 	var err error
-	provider, err := virtualworkspace.New(cfg, &apisv1alpha1.APIBinding{}, virtualworkspace.Options{
-		Scheme: clientgoscheme.Scheme,
-	})
-	if err != nil {
-		setupLog.Error(err, "unable to construct cluster provider")
-		os.Exit(1)
+	var provider multicluster.Provider
+	var runProvider func(context.Context, mcmanager.Manager) error
+	if !singleCluster {
+		newProvider := func() (supervisor.RunnableProvider, error) {
+			return virtualworkspace.New(cfg, &apisv1alpha1.APIBinding{}, virtualworkspace.Options{
+				Scheme: clientgoscheme.Scheme,
+			})
+		}
+		if _, err := newProvider(); err != nil {
+			setupLog.Error(err, "unable to construct cluster provider")
+			os.Exit(1)
+		}
+		// Every supervised run gets a fresh virtual workspace provider, as a
+		// failed one cannot be run again.
+		supervised := supervisor.NewProvider(newProvider)
+		provider, runProvider = supervised, supervised.Run
 	}
 
-	providerKubeConfig = filepath.Clean(providerKubeConfig)
-	if _, err := os.Stat(providerKubeConfig); err != nil {
-		setupLog.Error(err, "unable to find provider kubeconfig")
-		os.Exit(1)
+	buildProviderConfig := func() (*rest.Config, error) {
+		return clientcmd.BuildConfigFromFlags("", providerKubeConfig)
 	}
-	config, err := clientcmd.BuildConfigFromFlags("", providerKubeConfig)
-	if err != nil {
-		setupLog.Error(err, "unable to build provider kubeconfig")
-		os.Exit(1)
+	if singleCluster && providerKubeConfig == "" {
+		buildProviderConfig = func() (*rest.Config, error) {
+			return rest.CopyConfig(cfg), nil
+		}
+	} else {
+		providerKubeConfig = filepath.Clean(providerKubeConfig)
+		if _, err := os.Stat(providerKubeConfig); err != nil {
+			setupLog.Error(err, "unable to find provider kubeconfig")
+			os.Exit(1)
+		}
 	}
-
-	providerClusterDynamicClient, err := client.New(config, client.Options{
-		Scheme: clientgoscheme.Scheme,
-	})
+	providerConfig := func() (*rest.Config, error) {
+		config, err := buildProviderConfig()
+		if err != nil {
+			return nil, fmt.Errorf("unable to build provider kubeconfig: %w", err)
+		}
+		setRateLimits(config, providerQPS, providerBurst)
+		return config, nil
+	}
+	providerClientOptions := client.Options{Scheme: clientgoscheme.Scheme}
+	buildProviderClient := providerclient.Direct(providerConfig, providerClientOptions)
+	if providerCache {
+		buildProviderClient = providerclient.Cached(ctx, providerConfig, providerClientOptions)
+	}
+	providerClusterDynamicClient, err := providerclient.New(buildProviderClient, providerHealthCheckInterval)
 	if err != nil {
 		setupLog.Error(err, "unable to create dynamic client")
 		os.Exit(1)
 	}
 
-	mgr, err := mcmanager.New(cfg, provider, ctrl.Options{
-		Scheme:                 clientgoscheme.Scheme,
-		Metrics:                metricsServerOptions,
-		WebhookServer:          webhookServer,
-		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "e3eac106.contrib.kcp.io",
-		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
-		// when the Manager ends. This requires the binary to immediately end when the
-		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
-		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
-	})
+	var providerClient client.Client = providerClusterDynamicClient
+	var reconcileThrottle *throttle.Throttle
+	if throttleRecoveryInterval > 0 {
+		reconcileThrottle = throttle.New(maxConcurrentReconciles, throttleRecoveryInterval)
+		providerClient = throttle.NewClient(providerClient, reconcileThrottle)
+	}
+	if auditLogPath != "" {
+		auditLog, err := os.OpenFile(filepath.Clean(auditLogPath), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			setupLog.Error(err, "unable to open audit log")
+			os.Exit(1)
+		}
+		providerClient = audit.NewClient(providerClient, auditLog)
+	}
+
+	var discovery types.NamespacedName
+	if discoveryConfigMap != "" {
+		namespace, name, ok := strings.Cut(discoveryConfigMap, "/")
+		if !ok || namespace == "" || name == "" {
+			setupLog.Error(fmt.Errorf("expected namespace/name, got %q", discoveryConfigMap), "invalid --discovery-configmap")
+			os.Exit(1)
+		}
+		discovery = types.NamespacedName{Namespace: namespace, Name: name}
+	}
+
+	var connectivityDialer controller.DialFunc
+	if verifyConnectivity {
+		connectivityDialer = (&net.Dialer{}).DialContext
+	}
+
+	mgrOptions := ctrl.Options{
+		Scheme:                  clientgoscheme.Scheme,
+		Metrics:                 metricsServerOptions,
+		WebhookServer:           webhookServer,
+		HealthProbeBindAddress:  probeAddr,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        "e3eac106.contrib.kcp.io",
+		GracefulShutdownTimeout: &gracefulShutdownTimeout,
+	}
+	if warning := setLeaderElectionReleaseOnCancel(&mgrOptions, leaderElectionReleaseOnCancel); warning != "" {
+		setupLog.Info(warning)
+	}
+	mgr, err := mcmanager.New(cfg, provider, mgrOptions)
 	if err != nil {
 		setupLog.Error(err, "unable to set up overall controller manager")
 		os.Exit(1)
 	}
 
+	applications := inventory.New()
+	reconcileProgress := progress.NewTracker(stuckReconcileWindow)
+	var metricsWorkspaces *controller.WorkspaceLabels
+	if metricsWorkspaceLabel {
+		metricsWorkspaces = controller.NewWorkspaceLabels(metricsMaxWorkspaces)
+	}
+
+	predicates := []predicate.Predicate{controller.ApplicationPredicate()}
+	if noStatusSubresource {
+		predicates = []predicate.Predicate{controller.ApplicationPredicateWithoutStatusSubresource()}
+	}
+	var hooks []controller.ReconcileHook
+	var requiredLabelKeys []string
+	if requiredLabels != "" {
+		requiredLabelKeys = strings.Split(requiredLabels, ",")
+		hooks = append(hooks, controller.LabelPropagationHook{Keys: requiredLabelKeys})
+	}
+
+	var requiredAnnotationRequirement *controller.AnnotationRequirement
+	if requiredAnnotation != "" {
+		requiredAnnotationRequirement, err = controller.ParseAnnotationRequirement(requiredAnnotation)
+		if err != nil {
+			setupLog.Error(err, "unable to parse --required-annotation")
+			os.Exit(1)
+		}
+		predicates = append(predicates, controller.RequiredAnnotationPredicate(requiredAnnotationRequirement))
+	}
+
+	trigger := resync.NewTrigger(applications)
+
+	// A workspace is reported unhealthy after this many failed reconciles in
+	// a row. Its Applications are still retried with backoff.
+	workspaceHealth := controller.NewWorkspaceHealth(5)
+
 	if err := mcbuilder.ControllerManagedBy(mgr).
 		Named("kcp-applications-controller").
-		For(&applicationapisv1alpha1.Application{}).
+		For(&applicationapisv1alpha1.Application{}, mcbuilder.WithPredicates(predicates...)).
+		WatchesRawSource(trigger.Source()).
+		WithOptions(crcontroller.TypedOptions[mcreconcile.Request]{
+			MaxConcurrentReconciles: maxConcurrentReconciles,
+			NewQueue:                debounce.NewQueue[mcreconcile.Request](reconcileDebounce),
+			RateLimiter:             controller.NewRateLimiter[mcreconcile.Request](maxRequeueInterval),
+		}).
 		Complete(mcreconcile.Func(
 			func(ctx context.Context, req mcreconcile.Request) (ctrl.Result, error) {
+				if reconcileThrottle != nil {
+					release, err := reconcileThrottle.Acquire(ctx)
+					if err != nil {
+						return reconcile.Result{}, err
+					}
+					defer release()
+				}
+				defer reconcileProgress.Start()()
+
 				log := log.FromContext(ctx).WithValues("cluster", req.ClusterName)
 				log.Info("Reconciling Application")
 
 				cl, err := mgr.GetCluster(ctx, req.ClusterName)
 				if err != nil {
-					return reconcile.Result{}, fmt.Errorf("failed to get cluster: %w", err)
+					err = fmt.Errorf("failed to get cluster: %w", err)
+					workspaceHealth.Observe(ctx, req.ClusterName, err)
+					return reconcile.Result{}, err
 				}
 				client := cl.GetClient()
 
 				reconciler := &controller.ApplicationReconciler{
-					Client:         client,
-					Scheme:         cl.GetScheme(),
-					ProviderClient: providerClusterDynamicClient,
+					Client:                   client,
+					Scheme:                   cl.GetScheme(),
+					ProviderClient:           providerClient,
+					ObserveOnly:              observeOnly,
+					Workspace:                req.ClusterName,
+					Inventory:                applications,
+					MetricsWorkspaces:        metricsWorkspaces,
+					Approval:                 approval,
+					Naming:                   naming,
+					DefaultProviderNamespace: defaultProviderNamespace,
+					Recorder:                 cl.GetEventRecorderFor("application-controller"),
+					PostCreateRequeueDelay:   postCreateRequeueDelay,
+					MaxRequeueInterval:       maxRequeueInterval,
+					MaxTotalApplications:     maxTotalApplications,
+					ConnectivityDialer:       connectivityDialer,
+					SingleCluster:            singleCluster,
+					DiscoveryConfigMap:       discovery,
+					Hooks:                    hooks,
+					Forced:                   trigger.Forced(req),
+					NoStatusSubresource:      noStatusSubresource,
+					PropagateServerCA:        propagateServerCA,
+					CoalesceStatusUpdates:    coalesceStatusUpdates,
+					RequiredAnnotation:       requiredAnnotationRequirement,
 				}
-				return reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: req.NamespacedName})
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: req.NamespacedName})
+				workspaceHealth.Observe(ctx, req.ClusterName, err)
+				return result, err
 			},
 		)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Application")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		var namePatternRE *regexp.Regexp
+		if namePattern != "" {
+			namePatternRE, err = webhookapisv1alpha1.CompileNamePattern(namePattern)
+			if err != nil {
+				setupLog.Error(err, "unable to compile --name-pattern")
+				os.Exit(1)
+			}
+		}
+		validator := &webhookapisv1alpha1.ApplicationCustomValidator{
+			NamePattern:    namePatternRE,
+			RequiredLabels: requiredLabelKeys,
+		}
+		if admissionPreCheck {
+			checker := &controller.ApplicationReconciler{
+				ProviderClient:           providerClusterDynamicClient,
+				Naming:                   naming,
+				DefaultProviderNamespace: defaultProviderNamespace,
+				SingleCluster:            singleCluster,
+			}
+			validator.PreCheck = checker.PreCheck
+		}
+		if rejectNameCollisions {
+			validator.NameCollision = func(ctx context.Context, app *applicationapisv1alpha1.Application) (string, error) {
+				// Without kcp the annotation is missing, which selects the
+				// local cluster.
+				cl, err := mgr.GetCluster(ctx, app.Annotations["kcp.io/cluster"])
+				if err != nil {
+					return "", err
+				}
+				checker := &controller.ApplicationReconciler{
+					Client:                   cl.GetClient(),
+					Naming:                   naming,
+					DefaultProviderNamespace: defaultProviderNamespace,
+					SingleCluster:            singleCluster,
+				}
+				return checker.NameCollision(ctx, app)
+			}
+		}
+		if err := webhookapisv1alpha1.SetupApplicationWebhookWithManager(mgr.GetLocalManager(), validator); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Application")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
-	// TODO(mjudeikis): This needs to be implemented in mcmanager.
-	// if metricsCertWatcher != nil {
-	//	setupLog.Info("Adding metrics certificate watcher to manager")
-	//	if err := mgr.Add(metricsCertWatcher); err != nil {
-	//		setupLog.Error(err, "unable to add metrics certificate watcher to manager")
-	//		os.Exit(1)
-	//	}
-	// }
-	//
-	// if webhookCertWatcher != nil {
-	//	setupLog.Info("Adding webhook certificate watcher to manager")
-	//	if err := mgr.Add(webhookCertWatcher); err != nil {
-	//		setupLog.Error(err, "unable to add webhook certificate watcher to manager")
-	//		os.Exit(1)
-	//	}
-	// }
+	// MULTICLUSTER: The certificate watchers are not multicluster-aware, so
+	// they are added to the local manager instead.
+	if metricsCertWatcher != nil {
+		setupLog.Info("Adding metrics certificate watcher to manager")
+		if err := mgr.GetLocalManager().Add(metricsCertWatcher); err != nil {
+			setupLog.Error(err, "unable to add metrics certificate watcher to manager")
+			os.Exit(1)
+		}
+	}
+
+	if webhookCertWatcher != nil {
+		setupLog.Info("Adding webhook certificate watcher to manager")
+		if err := mgr.GetLocalManager().Add(webhookCertWatcher); err != nil {
+			setupLog.Error(err, "unable to add webhook certificate watcher to manager")
+			os.Exit(1)
+		}
+
+		if manageWebhookConfig {
+			injector := webhookcert.NewCABundleInjector(
+				mgr.GetLocalManager().GetClient(),
+				filepath.Join(webhookCertPath, webhookCAName),
+				[]string{validatingWebhookConfig},
+				[]string{mutatingWebhookConfig},
+			)
+			webhookCertWatcher.RegisterCallback(injector.OnRotation)
+			if err := mgr.GetLocalManager().Add(injector); err != nil {
+				setupLog.Error(err, "unable to add webhook CA bundle injector to manager")
+				os.Exit(1)
+			}
+		}
+	}
+
+	if adminAddr != "0" {
+		adminOpts := admin.Options{
+			BindAddress:   adminAddr,
+			SecureServing: secureAdmin,
+			TLSOpts:       tlsOpts,
+		}
+		if secureAdmin {
+			if len(adminCertPath) == 0 {
+				setupLog.Error(nil, "--admin-cert-path is required when serving the admin API securely")
+				os.Exit(1)
+			}
+			adminCertWatcher, err := certwatcher.New(
+				filepath.Join(adminCertPath, adminCertName),
+				filepath.Join(adminCertPath, adminCertKey),
+			)
+			if err != nil {
+				setupLog.Error(err, "Failed to initialize admin API certificate watcher")
+				os.Exit(1)
+			}
+			adminOpts.TLSOpts = append(adminOpts.TLSOpts, func(config *tls.Config) {
+				config.GetCertificate = adminCertWatcher.GetCertificate
+			})
+
+			httpClient, err := rest.HTTPClientFor(cfg)
+			if err != nil {
+				setupLog.Error(err, "unable to create HTTP client for admin API authn/authz")
+				os.Exit(1)
+			}
+			adminOpts.Filter, err = filters.WithAuthenticationAndAuthorization(cfg, httpClient)
+			if err != nil {
+				setupLog.Error(err, "unable to set up admin API authn/authz")
+				os.Exit(1)
+			}
+		}
+		if err := mgr.GetLocalManager().Add(admin.NewServer(adminOpts, applications)); err != nil {
+			setupLog.Error(err, "unable to add admin API server to manager")
+			os.Exit(1)
+		}
+	}
+
+	if err := mgr.GetLocalManager().Add(trigger); err != nil {
+		setupLog.Error(err, "unable to add SIGHUP resync trigger to manager")
+		os.Exit(1)
+	}
+
+	holder, err := os.Hostname()
+	if err != nil {
+		setupLog.Error(err, "unable to determine the leader election identity")
+		os.Exit(1)
+	}
+	if err := mgr.GetLocalManager().Add(leader.NewTracker(holder)); err != nil {
+		setupLog.Error(err, "unable to add leader tracker to manager")
+		os.Exit(1)
+	}
+
+	if err := mgr.GetLocalManager().Add(providerClusterDynamicClient); err != nil {
+		setupLog.Error(err, "unable to add provider connection check to manager")
+		os.Exit(1)
+	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
+	if err := mgr.AddHealthzCheck("reconcile-progress", reconcileProgress.Check); err != nil {
+		setupLog.Error(err, "unable to set up reconcile progress check")
+		os.Exit(1)
+	}
 	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if err := mgr.AddReadyzCheck("provider-connection", providerClusterDynamicClient.Check); err != nil {
+		setupLog.Error(err, "unable to set up provider connection check")
+		os.Exit(1)
+	}
 
-	if provider != nil {
+	if runProvider != nil {
 		setupLog.Info("Starting provider")
+		providerOptions := supervisor.DefaultOptions
+		providerOptions.MaxConsecutiveFailures = providerMaxRestarts
 		go func() {
-			if err := provider.Run(ctx, mgr); err != nil {
+			run := func(ctx context.Context) error { return runProvider(ctx, mgr) }
+			if err := supervisor.Run(ctx, "provider", run, providerOptions); err != nil {
 				setupLog.Error(err, "unable to run provider")
 				os.Exit(1)
 			}
 		}()
 	}
 
+	if runSelftest {
+		selftestConfig, err := clientcmd.BuildConfigFromFlags("", filepath.Clean(selftestKubeConfig))
+		if err != nil {
+			setupLog.Error(err, "unable to build selftest kubeconfig")
+			os.Exit(1)
+		}
+		selftestClient, err := client.New(selftestConfig, client.Options{
+			Scheme: clientgoscheme.Scheme,
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to create selftest client")
+			os.Exit(1)
+		}
+
+		go func() {
+			if err := selftest.Run(ctx, selftestClient, selftestOpts); err != nil {
+				setupLog.Error(err, "self-test failed")
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}()
+	}
+
 	setupLog.Info("starting manager", "server", server)
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 }
+
+// setRateLimits overrides the client-side rate limits of cfg. Zero values
+// leave the corresponding client-go default in place.
+func setRateLimits(cfg *rest.Config, qps float64, burst int) {
+	if qps > 0 {
+		cfg.QPS = float32(qps)
+	}
+	if burst > 0 {
+		cfg.Burst = burst
+	}
+}
+
+// setLeaderElectionReleaseOnCancel sets whether the leader steps down
+// voluntarily when the manager stops. The lease is released once the
+// manager gave up waiting for its runnables, so with a finite graceful
+// shutdown timeout reconciles still draining may overlap with the next
+// leader; a warning describing that is returned.
+func setLeaderElectionReleaseOnCancel(opts *ctrl.Options, release bool) string {
+	opts.LeaderElectionReleaseOnCancel = release
+	if !release || !opts.LeaderElection {
+		return ""
+	}
+	if opts.GracefulShutdownTimeout != nil && *opts.GracefulShutdownTimeout < 0 {
+		return ""
+	}
+	return "Leader election is released on shutdown, reconciles still running after the graceful shutdown timeout " +
+		"may overlap with the next leader"
+}
+
+// tlsVersions are the values accepted by --tls-min-version.
+var tlsVersions = map[string]uint16{
+	"VersionTLS10": tls.VersionTLS10,
+	"VersionTLS11": tls.VersionTLS11,
+	"VersionTLS12": tls.VersionTLS12,
+	"VersionTLS13": tls.VersionTLS13,
+}
+
+// tlsSecurityOpts returns a TLS option enforcing minVersion and
+// cipherSuites, given by their Go names. Empty values leave the Go defaults
+// in place.
+func tlsSecurityOpts(minVersion string, cipherSuites []string) (func(*tls.Config), error) {
+	var version uint16
+	if minVersion != "" {
+		var ok bool
+		if version, ok = tlsVersions[minVersion]; !ok {
+			return nil, fmt.Errorf("unknown TLS version %q", minVersion)
+		}
+	}
+
+	known := map[string]uint16{}
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		known[suite.Name] = suite.ID
+	}
+	var ids []uint16
+	for _, name := range cipherSuites {
+		id, ok := known[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+
+	return func(c *tls.Config) {
+		if version != 0 {
+			c.MinVersion = version
+		}
+		if len(ids) > 0 {
+			c.CipherSuites = ids
+		}
+	}, nil
+}
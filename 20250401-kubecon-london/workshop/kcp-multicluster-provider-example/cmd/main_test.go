@@ -0,0 +1,103 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/tls"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Client rate limits", func() {
+	It("should apply the configured QPS and burst", func() {
+		cfg := &rest.Config{}
+		setRateLimits(cfg, 50, 100)
+		Expect(cfg.QPS).To(Equal(float32(50)))
+		Expect(cfg.Burst).To(Equal(100))
+	})
+
+	It("should leave the defaults in place when unset", func() {
+		cfg := &rest.Config{QPS: 5, Burst: 10}
+		setRateLimits(cfg, 0, 0)
+		Expect(cfg.QPS).To(Equal(float32(5)))
+		Expect(cfg.Burst).To(Equal(10))
+	})
+})
+
+var _ = Describe("TLS options", func() {
+	It("should apply the configured min version and cipher suites", func() {
+		opt, err := tlsSecurityOpts("VersionTLS13", []string{
+			"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		c := &tls.Config{}
+		opt(c)
+		Expect(c.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+		Expect(c.CipherSuites).To(Equal([]uint16{
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		}))
+	})
+
+	It("should leave the defaults in place when unset", func() {
+		opt, err := tlsSecurityOpts("", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		c := &tls.Config{MinVersion: tls.VersionTLS12}
+		opt(c)
+		Expect(c.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+		Expect(c.CipherSuites).To(BeNil())
+	})
+
+	It("should reject unknown versions and cipher suites", func() {
+		_, err := tlsSecurityOpts("TLS1.3", nil)
+		Expect(err).To(MatchError(ContainSubstring(`unknown TLS version "TLS1.3"`)))
+
+		_, err = tlsSecurityOpts("", []string{"TLS_RSA_WITH_RC5"})
+		Expect(err).To(MatchError(ContainSubstring(`unknown TLS cipher suite "TLS_RSA_WITH_RC5"`)))
+	})
+})
+
+var _ = Describe("Leader election release on cancel", func() {
+	It("should be set from the flag", func() {
+		opts := ctrl.Options{LeaderElection: true}
+		setLeaderElectionReleaseOnCancel(&opts, true)
+		Expect(opts.LeaderElectionReleaseOnCancel).To(BeTrue())
+
+		setLeaderElectionReleaseOnCancel(&opts, false)
+		Expect(opts.LeaderElectionReleaseOnCancel).To(BeFalse())
+	})
+
+	It("should warn when reconciles may outlive the graceful shutdown", func() {
+		timeout := 30 * time.Second
+		opts := ctrl.Options{LeaderElection: true, GracefulShutdownTimeout: &timeout}
+		Expect(setLeaderElectionReleaseOnCancel(&opts, true)).To(ContainSubstring("graceful shutdown timeout"))
+		Expect(setLeaderElectionReleaseOnCancel(&opts, false)).To(BeEmpty())
+
+		timeout = -1
+		Expect(setLeaderElectionReleaseOnCancel(&opts, true)).To(BeEmpty())
+
+		opts.LeaderElection = false
+		timeout = 30 * time.Second
+		Expect(setLeaderElectionReleaseOnCancel(&opts, true)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,169 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	applicationapisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/controller"
+)
+
+// renderOptions configure the render subcommand.
+type renderOptions struct {
+	// Filename is the Application manifest to render, "-" reads stdin.
+	Filename string
+	// Output is the output format, yaml or json.
+	Output string
+	// Namespace is the provider namespace. It defaults to the kcp.io/cluster
+	// annotation of the Application.
+	Namespace string
+	// ClusterName is the CNPG Cluster the referenced Database belongs to.
+	ClusterName string
+	// DatabaseName is the name of the database inside the Cluster.
+	DatabaseName string
+	// Naming derives the names of the provider objects.
+	Naming controller.ProviderNaming
+}
+
+// runRender implements the render subcommand, which prints the provider
+// objects the controller would create for an Application manifest. The
+// Database, Cluster and secret it refers to are not looked up, the values
+// they would contribute are taken from flags or placeholders instead.
+func runRender(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("render", flag.ContinueOnError)
+	opts := renderOptions{}
+	fs.StringVar(&opts.Filename, "f", "-", "The Application manifest to render, - reads from stdin.")
+	fs.StringVar(&opts.Output, "o", "yaml", "The output format, one of yaml or json.")
+	fs.StringVar(&opts.Namespace, "namespace", "",
+		"The provider namespace. Defaults to the kcp.io/cluster annotation of the Application.")
+	fs.StringVar(&opts.ClusterName, "cluster-name", "", "The CNPG Cluster of the referenced Database. Defaults to the Database name.")
+	fs.StringVar(&opts.DatabaseName, "database-name", "app", "The name of the database inside the Cluster.")
+	fs.StringVar(&opts.Naming.Prefix, "provider-name-prefix", "", "The prefix of the provider object names.")
+	fs.StringVar(&opts.Naming.Suffix, "provider-name-suffix", "", "The suffix of the provider object names.")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	var data []byte
+	var err error
+	if opts.Filename == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(opts.Filename)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read Application manifest: %w", err)
+	}
+
+	out, err := render(data, opts)
+	if err != nil {
+		return err
+	}
+	_, err = stdout.Write(out)
+	return err
+}
+
+func render(manifest []byte, opts renderOptions) ([]byte, error) {
+	app := &applicationapisv1alpha1.Application{}
+	if err := yaml.UnmarshalStrict(manifest, app); err != nil {
+		return nil, fmt.Errorf("failed to parse Application manifest: %w", err)
+	}
+
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = app.Annotations["kcp.io/cluster"]
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("no provider namespace, set --namespace or the kcp.io/cluster annotation")
+	}
+	clusterName := opts.ClusterName
+	if clusterName == "" {
+		clusterName = app.Spec.DatabaseRef
+	}
+
+	db := &cnpgapiv1.Database{
+		ObjectMeta: metav1.ObjectMeta{Name: app.Spec.DatabaseRef, Namespace: namespace},
+		Spec: cnpgapiv1.DatabaseSpec{
+			Name:       opts.DatabaseName,
+			ClusterRef: corev1.LocalObjectReference{Name: clusterName},
+		},
+	}
+	dbCluster := &cnpgapiv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: namespace},
+	}
+	secret := corev1.Secret{
+		Data: map[string][]byte{
+			"username": []byte("<username>"),
+			"password": []byte("<password>"),
+		},
+	}
+
+	objs, err := controller.BuildProviderObjects(app, opts.Naming, namespace, db, dbCluster, secret)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		gvk, err := apiutil.GVKForObject(obj, clientgoscheme.Scheme)
+		if err != nil {
+			return nil, err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+
+	switch opts.Output {
+	case "json":
+		list := map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      objs,
+		}
+		out, err := json.MarshalIndent(list, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(out, '\n'), nil
+	case "yaml":
+		var out []byte
+		for _, obj := range objs {
+			doc, err := yaml.Marshal(obj)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, "---\n"...)
+			out = append(out, doc...)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported output format %q, must be yaml or json", opts.Output)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/controller"
+)
+
+const renderManifest = `apiVersion: apis.contrib.kcp.io/v1alpha1
+kind: Application
+metadata:
+  name: pgadmin
+  namespace: default
+  annotations:
+    kcp.io/cluster: 1ctnpog1ny8bnud6
+spec:
+  databaseRef: orders
+  databaseSecretRef:
+    name: orders-db
+`
+
+var _ = Describe("render", func() {
+	It("should render the provider objects as YAML", func() {
+		out, err := render([]byte(renderManifest), renderOptions{Output: "yaml", DatabaseName: "app"})
+		Expect(err).NotTo(HaveOccurred())
+
+		docs := strings.Split(strings.TrimPrefix(string(out), "---\n"), "---\n")
+		Expect(docs).To(HaveLen(3))
+
+		deployment := &appsv1.Deployment{}
+		Expect(yaml.Unmarshal([]byte(docs[0]), deployment)).To(Succeed())
+		Expect(deployment.Kind).To(Equal("Deployment"))
+		Expect(deployment.APIVersion).To(Equal("apps/v1"))
+		Expect(deployment.Namespace).To(Equal("1ctnpog1ny8bnud6"))
+		Expect(deployment.Name).To(Equal("pgadmin"))
+		Expect(deployment.Labels).To(HaveKeyWithValue(controller.ApplicationLabel, "pgadmin"))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Command[2]).To(ContainSubstring("orders-rw.1ctnpog1ny8bnud6.svc.cluster.local"))
+
+		svc := &corev1.Service{}
+		Expect(yaml.Unmarshal([]byte(docs[1]), svc)).To(Succeed())
+		Expect(svc.Kind).To(Equal("Service"))
+		Expect(svc.Name).To(Equal("pgadmin"))
+
+		cm := &corev1.ConfigMap{}
+		Expect(yaml.Unmarshal([]byte(docs[2]), cm)).To(Succeed())
+		Expect(cm.Kind).To(Equal("ConfigMap"))
+		Expect(cm.Name).To(Equal("pgadmin-servers"))
+		Expect(cm.Data["servers.json"]).To(ContainSubstring(`"Name":"orders"`))
+	})
+
+	It("should render a JSON list honouring the overrides", func() {
+		out, err := render([]byte(renderManifest), renderOptions{
+			Output:       "json",
+			Namespace:    "provider",
+			ClusterName:  "pg",
+			DatabaseName: "app",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		var list struct {
+			Kind  string            `json:"kind"`
+			Items []json.RawMessage `json:"items"`
+		}
+		Expect(json.Unmarshal(out, &list)).To(Succeed())
+		Expect(list.Kind).To(Equal("List"))
+		Expect(list.Items).To(HaveLen(3))
+
+		deployment := &appsv1.Deployment{}
+		Expect(json.Unmarshal(list.Items[0], deployment)).To(Succeed())
+		Expect(deployment.Namespace).To(Equal("provider"))
+		Expect(deployment.Spec.Template.Spec.Containers[0].Command[2]).To(ContainSubstring("pg-rw.provider.svc.cluster.local"))
+	})
+
+	It("should read the manifest from stdin", func() {
+		var stdout bytes.Buffer
+		Expect(runRender([]string{"-o", "yaml"}, strings.NewReader(renderManifest), &stdout)).To(Succeed())
+		Expect(stdout.String()).To(ContainSubstring("kind: Deployment"))
+	})
+
+	It("should reject an Application without a provider namespace", func() {
+		manifest := strings.Replace(renderManifest, "    kcp.io/cluster: 1ctnpog1ny8bnud6\n", "    example.com/other: x\n", 1)
+		_, err := render([]byte(manifest), renderOptions{Output: "yaml"})
+		Expect(err).To(MatchError(ContainSubstring("no provider namespace")))
+	})
+
+	It("should reject unknown output formats", func() {
+		_, err := render([]byte(renderManifest), renderOptions{Output: "toml"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package admin serves a stable JSON API describing the Applications
+// reconciled across all workspaces.
+package admin
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/inventory"
+)
+
+// ApplicationsPath is the path the Application listing is served on.
+const ApplicationsPath = "/applications"
+
+// Options configures the admin API server.
+type Options struct {
+	// BindAddress is the address the server listens on.
+	BindAddress string
+	// SecureServing serves the API via HTTPS.
+	SecureServing bool
+	// TLSOpts is applied to the TLS config when SecureServing is set. It must
+	// provide a certificate, e.g. via GetCertificate.
+	TLSOpts []func(*tls.Config)
+	// Filter wraps the API handler, e.g. with authentication and authorization.
+	Filter metricsserver.Filter
+}
+
+// ApplicationList is the response body of the Application listing.
+type ApplicationList struct {
+	Items []inventory.Record `json:"items"`
+}
+
+// Server serves the admin API. It implements manager.Runnable.
+type Server struct {
+	opts      Options
+	inventory *inventory.Inventory
+}
+
+// NewServer returns a Server exposing the records of inv.
+func NewServer(opts Options, inv *inventory.Inventory) *Server {
+	return &Server{opts: opts, inventory: inv}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the
+// leader reconciles Applications and fills the inventory, so the API is not
+// served by standby replicas, which would answer with an empty listing.
+func (s *Server) NeedLeaderElection() bool {
+	return true
+}
+
+// Handler returns the API handler, wrapped by the configured filter.
+func (s *Server) Handler(log logr.Logger) (http.Handler, error) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ApplicationsPath, s.serveApplications)
+
+	if s.opts.Filter == nil {
+		return mux, nil
+	}
+	handler, err := s.opts.Filter(log, mux)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply filter to admin API: %w", err)
+	}
+	return handler, nil
+}
+
+// Start serves the API until ctx is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("admin")
+
+	handler, err := s.Handler(log)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", s.opts.BindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.opts.BindAddress, err)
+	}
+	if s.opts.SecureServing {
+		cfg := &tls.Config{NextProtos: []string{"h2"}}
+		for _, opt := range s.opts.TLSOpts {
+			opt(cfg)
+		}
+		listener = tls.NewListener(listener, cfg)
+	}
+
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		if err := srv.Shutdown(context.Background()); err != nil {
+			log.Error(err, "failed to shut down admin API server")
+		}
+	}()
+
+	log.Info("Serving admin API", "address", listener.Addr().String(), "secure", s.opts.SecureServing)
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) serveApplications(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	list := ApplicationList{Items: s.inventory.List()}
+	if list.Items == nil {
+		list.Items = []inventory.Record{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
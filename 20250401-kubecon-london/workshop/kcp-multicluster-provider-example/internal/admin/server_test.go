@@ -0,0 +1,96 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/inventory"
+)
+
+var _ = Describe("Admin API", func() {
+	var inv *inventory.Inventory
+
+	BeforeEach(func() {
+		inv = inventory.New()
+		inv.Set(inventory.Record{
+			Workspace:         "root:b",
+			Namespace:         "default",
+			Name:              "app",
+			ProviderNamespace: "b",
+			Phase:             "Ready",
+			Endpoint:          "kubectl port-forward svc/app 8080:8080 -n b",
+		})
+		inv.Set(inventory.Record{Workspace: "root:a", Namespace: "default", Name: "app"})
+	})
+
+	It("should list Applications across workspaces", func() {
+		handler, err := NewServer(Options{}, inv).Handler(logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ApplicationsPath, nil))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		var body map[string][]map[string]string
+		Expect(json.Unmarshal(rec.Body.Bytes(), &body)).To(Succeed())
+		Expect(body["items"]).To(HaveLen(2))
+		Expect(body["items"][0]["workspace"]).To(Equal("root:a"))
+		Expect(body["items"][1]).To(Equal(map[string]string{
+			"workspace":         "root:b",
+			"namespace":         "default",
+			"name":              "app",
+			"providerNamespace": "b",
+			"phase":             "Ready",
+			"endpoint":          "kubectl port-forward svc/app 8080:8080 -n b",
+		}))
+	})
+
+	It("should return an empty list when nothing was reconciled", func() {
+		handler, err := NewServer(Options{}, inventory.New()).Handler(logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ApplicationsPath, nil))
+		Expect(rec.Body.String()).To(MatchJSON(`{"items":[]}`))
+	})
+
+	It("should only be served by the leader, whose inventory is filled", func() {
+		Expect(NewServer(Options{}, inventory.New()).NeedLeaderElection()).To(BeTrue())
+	})
+
+	It("should reject requests denied by the filter", func() {
+		deny := func(_ logr.Logger, _ http.Handler) (http.Handler, error) {
+			return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+			}), nil
+		}
+		handler, err := NewServer(Options{Filter: deny}, inv).Handler(logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ApplicationsPath, nil))
+		Expect(rec.Code).To(Equal(http.StatusForbidden))
+	})
+})
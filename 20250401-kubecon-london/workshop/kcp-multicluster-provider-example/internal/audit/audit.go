@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records the mutations the controller issues against the
+// provider as a structured trail.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Actor is the Application on whose behalf a mutation is issued.
+type Actor struct {
+	Workspace   string
+	Application types.NamespacedName
+}
+
+type actorKey struct{}
+
+// WithActor returns a context whose provider mutations are attributed to
+// actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Entry is a single audit record, written as one JSON line.
+type Entry struct {
+	Time        time.Time `json:"time"`
+	Workspace   string    `json:"workspace,omitempty"`
+	Application string    `json:"application,omitempty"`
+	Verb        string    `json:"verb"`
+	APIVersion  string    `json:"apiVersion"`
+	Kind        string    `json:"kind"`
+	Namespace   string    `json:"namespace,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Result      string    `json:"result"`
+	Error       string    `json:"error,omitempty"`
+}
+
+const (
+	// ResultSuccess is the Result of a mutation the provider accepted.
+	ResultSuccess = "success"
+	// ResultError is the Result of a mutation that failed.
+	ResultError = "error"
+)
+
+var _ client.Client = &Client{}
+
+// Client is a client.Client that writes an Entry for every create, update,
+// patch and delete to its sink. Reads are passed through unrecorded.
+type Client struct {
+	client.Client
+
+	mu   sync.Mutex
+	sink *json.Encoder
+}
+
+// NewClient returns a Client auditing the mutations issued through c to
+// sink.
+func NewClient(c client.Client, sink io.Writer) *Client {
+	return &Client{Client: c, sink: json.NewEncoder(sink)}
+}
+
+// Create implements client.Client.
+func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	err := c.Client.Create(ctx, obj, opts...)
+	c.record(ctx, "create", obj, err)
+	return err
+}
+
+// Update implements client.Client.
+func (c *Client) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	err := c.Client.Update(ctx, obj, opts...)
+	c.record(ctx, "update", obj, err)
+	return err
+}
+
+// Patch implements client.Client.
+func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	err := c.Client.Patch(ctx, obj, patch, opts...)
+	c.record(ctx, "patch", obj, err)
+	return err
+}
+
+// Delete implements client.Client.
+func (c *Client) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	err := c.Client.Delete(ctx, obj, opts...)
+	c.record(ctx, "delete", obj, err)
+	return err
+}
+
+// DeleteAllOf implements client.Client.
+func (c *Client) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	err := c.Client.DeleteAllOf(ctx, obj, opts...)
+	c.record(ctx, "deletecollection", obj, err)
+	return err
+}
+
+func (c *Client) record(ctx context.Context, verb string, obj client.Object, err error) {
+	entry := Entry{
+		Time:      time.Now().UTC(),
+		Verb:      verb,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Result:    ResultSuccess,
+	}
+	if actor, ok := ctx.Value(actorKey{}).(Actor); ok {
+		entry.Workspace = actor.Workspace
+		entry.Application = actor.Application.String()
+	}
+	if gvk, gvkErr := apiutil.GVKForObject(obj, c.Scheme()); gvkErr == nil {
+		entry.APIVersion, entry.Kind = gvk.GroupVersion().String(), gvk.Kind
+	}
+	if err != nil {
+		entry.Result, entry.Error = ResultError, err.Error()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// The trail is best-effort: a failing sink must not fail provisioning.
+	_ = c.sink.Encode(entry)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func entries(buf *bytes.Buffer) []Entry {
+	var out []Entry
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var e Entry
+		Expect(json.Unmarshal(scanner.Bytes(), &e)).To(Succeed())
+		out = append(out, e)
+	}
+	return out
+}
+
+var _ = Describe("Client", func() {
+	It("should record every mutation with its actor and result", func() {
+		var buf bytes.Buffer
+		c := NewClient(fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(), &buf)
+		ctx := WithActor(context.Background(), Actor{
+			Workspace:   "root:team-a",
+			Application: types.NamespacedName{Namespace: "default", Name: "app"},
+		})
+
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "app-servers"}}
+		Expect(c.Create(ctx, cm)).To(Succeed())
+		Expect(c.Create(ctx, cm.DeepCopy())).NotTo(Succeed())
+		cm.Data = map[string]string{"servers.json": "{}"}
+		Expect(c.Update(ctx, cm)).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})).To(Succeed())
+		Expect(c.Delete(ctx, cm)).To(Succeed())
+
+		recorded := entries(&buf)
+		Expect(recorded).To(HaveLen(4), "reads are not audited")
+		for _, e := range recorded {
+			Expect(e.Workspace).To(Equal("root:team-a"))
+			Expect(e.Application).To(Equal("default/app"))
+			Expect(e.APIVersion).To(Equal("v1"))
+			Expect(e.Kind).To(Equal("ConfigMap"))
+			Expect(e.Namespace).To(Equal("team-a"))
+			Expect(e.Name).To(Equal("app-servers"))
+			Expect(e.Time).NotTo(BeZero())
+		}
+		Expect(recorded[0].Verb).To(Equal("create"))
+		Expect(recorded[0].Result).To(Equal(ResultSuccess))
+		Expect(recorded[1].Verb).To(Equal("create"))
+		Expect(recorded[1].Result).To(Equal(ResultError))
+		Expect(recorded[1].Error).To(ContainSubstring("already exists"))
+		Expect(recorded[2].Verb).To(Equal("update"))
+		Expect(recorded[3].Verb).To(Equal("delete"))
+	})
+
+	It("should record mutations without an actor", func() {
+		var buf bytes.Buffer
+		c := NewClient(fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build(), &buf)
+
+		Expect(c.Create(context.Background(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cm"}})).To(Succeed())
+
+		recorded := entries(&buf)
+		Expect(recorded).To(HaveLen(1))
+		Expect(recorded[0].Workspace).To(BeEmpty())
+		Expect(recorded[0].Application).To(BeEmpty())
+	})
+})
@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config loads the controller configuration file. Values from the
+// file are applied on top of the flag defaults, and flags explicitly set on
+// the command line take precedence over the file.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ControllerConfig mirrors the command line flags of the controller. Unset
+// fields leave the corresponding flag untouched.
+type ControllerConfig struct {
+	// Server overrides the kubeconfig server URL (--server).
+	Server *string `json:"server,omitempty"`
+	// ProviderKubeconfig is the kubeconfig of the provider cluster
+	// (--provider-kubeconfig).
+	ProviderKubeconfig *string `json:"providerKubeconfig,omitempty"`
+	// MetricsBindAddress is the metrics endpoint address (--metrics-bind-address).
+	MetricsBindAddress *string `json:"metricsBindAddress,omitempty"`
+	// HealthProbeBindAddress is the probe endpoint address
+	// (--health-probe-bind-address).
+	HealthProbeBindAddress *string `json:"healthProbeBindAddress,omitempty"`
+	// LeaderElect enables leader election (--leader-elect).
+	LeaderElect *bool `json:"leaderElect,omitempty"`
+	// MaxConcurrentReconciles is the number of concurrent Application
+	// reconciles (--max-concurrent-reconciles).
+	MaxConcurrentReconciles *int `json:"maxConcurrentReconciles,omitempty"`
+	// GracefulShutdownTimeout bounds how long the manager waits for
+	// runnables to stop (--graceful-shutdown-timeout).
+	GracefulShutdownTimeout *metav1.Duration `json:"gracefulShutdownTimeout,omitempty"`
+	// KubeAPIQPS and KubeAPIBurst tune the kcp client (--kube-api-qps,
+	// --kube-api-burst).
+	KubeAPIQPS   *float64 `json:"kubeAPIQPS,omitempty"`
+	KubeAPIBurst *int     `json:"kubeAPIBurst,omitempty"`
+	// ProviderQPS and ProviderBurst tune the provider client (--provider-qps,
+	// --provider-burst).
+	ProviderQPS   *float64 `json:"providerQPS,omitempty"`
+	ProviderBurst *int     `json:"providerBurst,omitempty"`
+	// ObserveOnly disables all provider writes (--observe-only).
+	ObserveOnly *bool `json:"observeOnly,omitempty"`
+	// FeatureGates toggles feature gates (--feature-gates).
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// Load reads a ControllerConfig from a YAML file. Unknown fields are rejected.
+func Load(path string) (*ControllerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	cfg := &ControllerConfig{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// flagValues returns the set fields of c keyed by their flag name.
+func (c *ControllerConfig) flagValues() map[string]string {
+	values := map[string]string{}
+	setString := func(name string, v *string) {
+		if v != nil {
+			values[name] = *v
+		}
+	}
+	setBool := func(name string, v *bool) {
+		if v != nil {
+			values[name] = strconv.FormatBool(*v)
+		}
+	}
+	setInt := func(name string, v *int) {
+		if v != nil {
+			values[name] = strconv.Itoa(*v)
+		}
+	}
+	setFloat := func(name string, v *float64) {
+		if v != nil {
+			values[name] = strconv.FormatFloat(*v, 'f', -1, 64)
+		}
+	}
+
+	setString("server", c.Server)
+	setString("provider-kubeconfig", c.ProviderKubeconfig)
+	setString("metrics-bind-address", c.MetricsBindAddress)
+	setString("health-probe-bind-address", c.HealthProbeBindAddress)
+	setBool("leader-elect", c.LeaderElect)
+	setInt("max-concurrent-reconciles", c.MaxConcurrentReconciles)
+	if c.GracefulShutdownTimeout != nil {
+		values["graceful-shutdown-timeout"] = c.GracefulShutdownTimeout.Duration.String()
+	}
+	setFloat("kube-api-qps", c.KubeAPIQPS)
+	setInt("kube-api-burst", c.KubeAPIBurst)
+	setFloat("provider-qps", c.ProviderQPS)
+	setInt("provider-burst", c.ProviderBurst)
+	setBool("observe-only", c.ObserveOnly)
+	if len(c.FeatureGates) > 0 {
+		gates := make([]string, 0, len(c.FeatureGates))
+		for name, enabled := range c.FeatureGates {
+			gates = append(gates, fmt.Sprintf("%s=%t", name, enabled))
+		}
+		sort.Strings(gates)
+		values["feature-gates"] = strings.Join(gates, ",")
+	}
+
+	return values
+}
+
+// Apply sets the flags of fs from c, skipping flags that were explicitly set
+// on the command line. It must be called after fs has been parsed.
+func Apply(fs *flag.FlagSet, c *ControllerConfig) error {
+	explicit := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+
+	values := c.flagValues()
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if explicit[name] {
+			continue
+		}
+		if fs.Lookup(name) == nil {
+			return fmt.Errorf("config field for unknown flag --%s", name)
+		}
+		if err := fs.Set(name, values[name]); err != nil {
+			return fmt.Errorf("invalid value for --%s in config file: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ControllerConfig", func() {
+	var (
+		fs           *flag.FlagSet
+		kubeconfig   string
+		concurrency  int
+		qps          float64
+		leaderElect  bool
+		shutdown     time.Duration
+		featureGates string
+	)
+
+	writeConfig := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		fs = flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.StringVar(&kubeconfig, "provider-kubeconfig", "default.kubeconfig", "")
+		fs.IntVar(&concurrency, "max-concurrent-reconciles", 1, "")
+		fs.Float64Var(&qps, "provider-qps", 0, "")
+		fs.BoolVar(&leaderElect, "leader-elect", false, "")
+		fs.DurationVar(&shutdown, "graceful-shutdown-timeout", 30*time.Second, "")
+		fs.StringVar(&featureGates, "feature-gates", "", "")
+	})
+
+	It("should apply file values over defaults and keep explicit flags", func() {
+		path := writeConfig(`
+providerKubeconfig: file.kubeconfig
+maxConcurrentReconciles: 4
+providerQPS: 25.5
+leaderElect: true
+gracefulShutdownTimeout: 1m
+featureGates:
+  B: false
+  A: true
+`)
+		Expect(fs.Parse([]string{"--max-concurrent-reconciles=8"})).To(Succeed())
+
+		cfg, err := Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(Apply(fs, cfg)).To(Succeed())
+
+		Expect(kubeconfig).To(Equal("file.kubeconfig"))
+		Expect(concurrency).To(Equal(8), "flags take precedence over the file")
+		Expect(qps).To(Equal(25.5))
+		Expect(leaderElect).To(BeTrue())
+		Expect(shutdown).To(Equal(time.Minute))
+		Expect(featureGates).To(Equal("A=true,B=false"))
+	})
+
+	It("should keep defaults for fields missing from the file", func() {
+		path := writeConfig("leaderElect: true\n")
+		Expect(fs.Parse(nil)).To(Succeed())
+
+		cfg, err := Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(Apply(fs, cfg)).To(Succeed())
+
+		Expect(kubeconfig).To(Equal("default.kubeconfig"))
+		Expect(concurrency).To(Equal(1))
+		Expect(leaderElect).To(BeTrue())
+	})
+
+	It("should reject unknown fields", func() {
+		_, err := Load(writeConfig("unknownField: true\n"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -20,28 +20,46 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
 
 	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/audit"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/featuregate"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/inventory"
 )
 
 const (
 	// FinalizerName is the finalizer name for the Application CRD
 	FinalizerName = "finalizer.apis.contrib.kcp.io/no-no-no"
+
+	// quotaRequeueInterval is how long to wait before re-checking a provider
+	// namespace whose quota blocked provisioning.
+	quotaRequeueInterval = time.Minute
+
+	// capacityRequeueInterval is how long to wait before re-checking an
+	// Application held back by MaxTotalApplications.
+	capacityRequeueInterval = time.Minute
 )
 
 // ApplicationReconciler reconciles a Application object
@@ -50,11 +68,79 @@ type ApplicationReconciler struct {
 	Scheme *runtime.Scheme
 
 	ProviderClient client.Client
+
+	// ObserveOnly makes the reconciler report what it would provision on the
+	// Application status without ever writing to the provider.
+	ObserveOnly bool
+
+	// Workspace is the logical cluster the reconciled Applications live in.
+	Workspace string
+	// Inventory, if set, is kept up to date with every reconciled Application.
+	Inventory *inventory.Inventory
+	// MetricsWorkspaces, if set, labels the reconcile metrics by workspace.
+	MetricsWorkspaces *WorkspaceLabels
+	// Approval, if set, holds back provisioning until it is approved in the
+	// workspace.
+	Approval ApprovalSource
+	// DefaultProviderNamespace, if set, is the provider namespace of all
+	// Applications instead of one namespace per workspace. Naming should be
+	// unique then.
+	DefaultProviderNamespace string
+	// Naming derives the names of the provider objects.
+	Naming ProviderNaming
+	// Recorder, if set, records events on the reconciled Applications.
+	Recorder record.EventRecorder
+	// PostCreateRequeueDelay, if set, is how long to wait before checking an
+	// Application again after its provider objects were created.
+	PostCreateRequeueDelay time.Duration
+	// MaxRequeueInterval, if set, caps how long an Application waits before
+	// it is checked again.
+	MaxRequeueInterval time.Duration
+	// MaxTotalApplications, if set, caps the number of provisioned
+	// Applications across all workspaces. It is counted on the Inventory,
+	// which must be set and is only complete once every Application was
+	// reconciled, so the cap is a safety net rather than an exact limit.
+	MaxTotalApplications int
+	// ConnectivityDialer, if set, is used to verify that the database is
+	// reachable from the controller before an Application is marked Ready.
+	ConnectivityDialer DialFunc
+	// SingleCluster, if set, provisions Applications without a kcp.io/cluster
+	// annotation into their own namespace, for running without kcp.
+	SingleCluster bool
+	// DiscoveryConfigMap, if set, is the provider ConfigMap the endpoints of
+	// every Ready Application are published to.
+	DiscoveryConfigMap types.NamespacedName
+	// Hooks are called, in order, around applying the provider objects.
+	Hooks []ReconcileHook
+	// Forced runs a full provisioning pass even if the spec hash is
+	// unchanged, as after a resync.
+	Forced bool
+	// PropagateServerCA, if set, copies the CA of the database server
+	// certificate into the Application's namespace, see ServerCASecretName.
+	PropagateServerCA bool
+	// CoalesceStatusUpdates skips status updates that would not change the
+	// status, and refreshes LastReconcileTime only every few minutes.
+	CoalesceStatusUpdates bool
+	// NoStatusSubresource writes the Application status with full updates,
+	// for CRDs installed without a status subresource. Each such write bumps
+	// the generation, so the ObservedGeneration of the conditions trails the
+	// generation by one, and spec changes must be detected by comparing specs
+	// as ApplicationPredicateWithoutStatusSubresource does.
+	NoStatusSubresource bool
+	// RequiredAnnotation, if set, restricts provisioning to the Applications
+	// matching it. Other Applications are left alone, but are still
+	// finalized when they are deleted.
+	RequiredAnnotation *AnnotationRequirement
+
+	// writtenStatus is the Application status last read or written in the
+	// current reconcile, if status updates are coalesced.
+	writtenStatus *apisv1alpha1.ApplicationStatus
 }
 
 // +kubebuilder:rbac:groups=apis.contrib.kcp.io,resources=applications,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apis.contrib.kcp.io,resources=applications/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=apis.contrib.kcp.io,resources=applications/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -66,28 +152,172 @@ type ApplicationReconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.20.2/pkg/reconcile
 func (r *ApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	start := time.Now()
 
 	app := &apisv1alpha1.Application{}
 	if err := r.Client.Get(ctx, req.NamespacedName, app); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Inventory.Delete(r.Workspace, req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	// The same provider objects are read by several checks and when applying
+	// them, so reads are cached for the duration of the reconcile.
+	scoped := *r
+	scoped.ProviderClient = newReadCache(r.ProviderClient)
+	if r.CoalesceStatusUpdates {
+		scoped.writtenStatus = app.Status.DeepCopy()
+	}
+	r = &scoped
+
+	ctx = withLogLevel(ctx, app)
+	ctx = audit.WithActor(ctx, audit.Actor{Workspace: r.Workspace, Application: req.NamespacedName})
+	log := log.FromContext(ctx)
+
+	summary := &reconcileSummary{action: actionNoOp}
+	var result ctrl.Result
+	var err error
+	switch paused, observe := pauseState(app); {
+	case app.DeletionTimestamp.IsZero() && !r.RequiredAnnotation.Matches(app):
+		log.V(1).Info("Application does not bear the required annotation, skipping reconcile")
+	case paused && observe:
+		observer := *r
+		observer.ObserveOnly = true
+		result, err = observer.reconcile(ctx, app, summary)
+	case paused:
+		log.V(1).Info("Application is paused, skipping reconcile")
+		err = r.setPaused(ctx, app)
+	default:
+		result, err = r.reconcile(ctx, app, summary)
+	}
+	if err != nil {
+		summary.action = actionFailed
+	}
+	duration := time.Since(start)
+	summary.log(log, app, duration)
+	r.recordMetrics(summary.action, duration)
+
+	result = capRequeue(result, r.MaxRequeueInterval)
+
+	if !app.DeletionTimestamp.IsZero() {
+		r.Inventory.Delete(r.Workspace, req.NamespacedName)
+		return result, err
+	}
+	r.Inventory.Set(inventory.Record{
+		Workspace:         r.Workspace,
+		Namespace:         app.Namespace,
+		Name:              app.Name,
+		ProviderNamespace: summary.namespace,
+		Phase:             app.Status.Status,
+		Endpoint:          app.Status.ConnectionString,
+		Provisioned:       controllerutil.ContainsFinalizer(app, FinalizerName),
+	})
+
+	return result, err
+}
+
+func (r *ApplicationReconciler) reconcile(
+	ctx context.Context,
+	app *apisv1alpha1.Application,
+	summary *reconcileSummary,
+) (ctrl.Result, error) {
+	namespace, ok := r.providerNamespace(app)
+	if !ok {
+		return ctrl.Result{}, fmt.Errorf("cluster label not found")
+	}
+	summary.namespace = namespace
+	log := log.FromContext(ctx).WithValues("providerNamespace", namespace)
+
+	if !app.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.finalize(ctx, app, namespace)
+	}
+
+	approved, err := r.isApproved(ctx, app)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !approved {
+		log.V(1).Info("Provisioning is not approved yet", "configMap", r.Approval.ConfigMapName)
+		app.Status.Status = "PendingApproval"
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type:               apisv1alpha1.ConditionReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: app.Generation,
+			Reason:             "PendingApproval",
+			Message:            fmt.Sprintf("Waiting for ConfigMap %s/%s to approve provisioning", app.Namespace, r.Approval.ConfigMapName),
+		})
+		if err := r.status().Update(ctx, app); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: approvalRequeueInterval}, nil
+	}
+
+	if !r.ObserveOnly {
+		if err := r.releaseOptedOut(ctx, app, namespace); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	hash, err := specHash(app, r.Hooks)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if featuregate.DefaultFeatureGate.Enabled(featuregate.SpecHashShortCircuit) && !r.Forced {
+		upToDate, err := r.isUpToDate(ctx, app, namespace, hash)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if upToDate {
+			log.V(1).Info("Spec is unchanged and provider is ready, skipping provisioning", "specHash", hash)
+			return ctrl.Result{}, r.refreshClusterStatus(ctx, app, namespace, summary)
+		}
+	}
+
+	nsExists, terminatingSince, err := r.providerNamespaceState(ctx, namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !terminatingSince.IsZero() {
+		if time.Since(terminatingSince) > namespaceTerminatingTimeout {
+			if err := r.setFailed(ctx, app, "NamespaceStuckTerminating",
+				fmt.Sprintf("Provider namespace %s has been Terminating since %s", namespace, terminatingSince.Format(time.RFC3339))); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: namespaceRequeueInterval}, nil
+		}
+		log.Info("Provider namespace is Terminating, waiting for it to be deleted")
+		if err := r.setPending(ctx, app, "NamespaceTerminating",
+			fmt.Sprintf("Waiting for provider namespace %s to finish terminating", namespace)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: namespaceRequeueInterval}, nil
+	}
+	if !nsExists {
+		message := fmt.Sprintf("Provider namespace %s does not exist, waiting for it to be recreated", namespace)
+		log.Info("Provider namespace is missing, waiting for it to be recreated")
+		if r.Recorder != nil {
+			r.Recorder.Event(app, corev1.EventTypeWarning, "ProviderNamespaceMissing", message)
+		}
+		if err := r.setPending(ctx, app, "ProviderNamespaceMissing", message); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: namespaceRequeueInterval}, nil
+	}
 
 	var secret corev1.Secret
-	err := r.Client.Get(ctx, client.ObjectKey{
-		Namespace: req.Namespace,
+	err = r.Client.Get(ctx, client.ObjectKey{
+		Namespace: app.Namespace,
 		Name:      app.Spec.DatabaseSecretRef.Name,
 	}, &secret)
+	if apierrors.IsNotFound(err) {
+		return r.waitForSecret(ctx, app)
+	}
 	if err != nil {
 		return ctrl.Result{
 			Requeue: true,
 		}, err
 	}
-
-	namespace, ok := app.Annotations["kcp.io/cluster"]
-	if !ok {
-		return ctrl.Result{}, fmt.Errorf("cluster label not found")
-	}
+	meta.RemoveStatusCondition(&app.Status.Conditions, apisv1alpha1.ConditionWaitingForSecret)
 
 	var db cnpgapiv1.Database
 	err = r.ProviderClient.Get(ctx, types.NamespacedName{
@@ -106,42 +336,274 @@ func (r *ApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	summary.cluster = dbCluster.Name
 
-	pgpass := newPgpassData(&db, &dbCluster, secret, namespace)
+	log.V(1).Info("Resolved provider database", "database", db.Name, "cluster", dbCluster.Name)
 
-	deployment, err := getApplicationDeployment(pgpass, app, namespace)
+	objs, err := BuildProviderObjects(app, r.Naming, namespace, &db, &dbCluster, secret)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	if err := r.beforeApply(ctx, app, &dbCluster, objs); err != nil {
+		return ctrl.Result{}, err
+	}
 
-	_, err = controllerutil.CreateOrUpdate(ctx, r.ProviderClient, deployment, func() error {
-		return nil
-	})
-	if err != nil {
+	if featuregate.DefaultFeatureGate.Enabled(featuregate.ProviderQuotaCheck) {
+		exceeded, err := r.checkQuota(ctx, namespace, objs...)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if exceeded != "" {
+			if err := r.setFailed(ctx, app, "ProviderQuotaExceeded", "Provider quota exceeded: "+exceeded); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: quotaRequeueInterval}, nil
+		}
+	}
+
+	if r.MaxTotalApplications > 0 && !r.ObserveOnly && !controllerutil.ContainsFinalizer(app, FinalizerName) {
+		if total := r.Inventory.CountProvisioned(); total >= r.MaxTotalApplications {
+			log.Info("Global Application capacity reached, not provisioning", "provisioned", total, "max", r.MaxTotalApplications)
+			if err := r.setPending(ctx, app, "GlobalCapacityExceeded",
+				fmt.Sprintf("%d of at most %d Applications are provisioned, waiting for capacity", total, r.MaxTotalApplications)); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: capacityRequeueInterval}, nil
+		}
+	}
+
+	if !r.ObserveOnly {
+		advanced, err := r.generationAdvanced(ctx, app)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if advanced {
+			log.Info("Application changed during reconcile, not applying the stale provider objects", "generation", app.Generation)
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	if !r.ObserveOnly && controllerutil.AddFinalizer(app, FinalizerName) {
+		if err := r.Client.Update(ctx, app); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	for _, obj := range objs {
+		if err := r.apply(ctx, obj, summary); err != nil {
+			if message, ok := providerRejection(err); ok {
+				log.Info("Provider rejected object", "object", client.ObjectKeyFromObject(obj), "reason", message)
+				summary.action = actionFailed
+				return ctrl.Result{}, r.setFailed(ctx, app, "ProviderRejected", message)
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	if !r.ObserveOnly {
+		if err := r.pruneIngress(ctx, app, namespace); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if err := r.afterApply(ctx, app, &dbCluster, objs); err != nil {
 		return ctrl.Result{}, err
 	}
+	if r.PropagateServerCA && !r.ObserveOnly {
+		if err := r.propagateServerCA(ctx, app, &dbCluster, namespace); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
 
-	svc, err := getApplicationService(app, namespace)
-	if err != nil {
+	// Update the status
+	before := slices.Clone(app.Status.Conditions)
+	now := metav1.Now()
+	mirrorClusterStatus(app, &dbCluster)
+	if summary.action == actionCreated {
+		recordMilestone(app, apisv1alpha1.MilestoneProviderObjectsCreated, now)
+	}
+	if !r.ObserveOnly && r.ConnectivityDialer != nil {
+		if err := r.verifyConnectivity(ctx, &db); err != nil {
+			log.Info("Database is unreachable, not marking the Application Ready", "address", databaseAddress(&db), "error", err.Error())
+			recordConditionMilestones(app, before, now)
+			if err := r.setPending(ctx, app, "DatabaseUnreachable",
+				fmt.Sprintf("Database %s is unreachable: %v", databaseAddress(&db), err)); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: connectivityRequeueInterval}, nil
+		}
+	}
+	if r.ObserveOnly {
+		app.Status.Status = "Observed"
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type:               apisv1alpha1.ConditionReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: app.Generation,
+			Reason:             "ObserveOnly",
+			Message:            summary.observedMessage(),
+		})
+	} else {
+		app.Status.Status = "Ready"
+		app.Status.ConnectionString = "kubectl port-forward svc/" + r.Naming.Name(app) + " 8080:8080 -n " + namespace
+		app.Status.ObservedSpecHash = hash
+		app.Status.ObservedForceReconcile = app.Annotations[ForceReconcileAnnotation]
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type:               apisv1alpha1.ConditionReady,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: app.Generation,
+			Reason:             "Provisioned",
+			Message:            "Application is provisioned on the provider",
+		})
+	}
+	recordConditionMilestones(app, before, now)
+	clearFailureHistory(app, now)
+	if r.CoalesceStatusUpdates {
+		touchLastReconcileTime(app, now)
+	} else {
+		app.Status.LastReconcileTime = &now
+	}
+
+	if err := r.status().Update(ctx, app); err != nil {
 		return ctrl.Result{}, err
 	}
+	if !r.ObserveOnly {
+		if err := r.publishDiscovery(ctx, app, &db, namespace); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
 
-	_, err = controllerutil.CreateOrUpdate(ctx, r.ProviderClient, svc, func() error {
+	if summary.action == actionCreated && r.PostCreateRequeueDelay > 0 {
+		return ctrl.Result{RequeueAfter: r.PostCreateRequeueDelay}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// setFailed marks the Application as failed for the given reason and
+// withdraws its discovery record.
+func (r *ApplicationReconciler) setFailed(ctx context.Context, app *apisv1alpha1.Application, reason, message string) error {
+	recordFailure(app, reason, message, metav1.Now())
+	app.Status.Status = "Failed"
+	meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               apisv1alpha1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: app.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := r.status().Update(ctx, app); err != nil {
+		return err
+	}
+	return r.unpublishNotReady(ctx, app)
+}
+
+// setPaused reports that the Application is paused and nothing is applied to
+// the provider.
+func (r *ApplicationReconciler) setPaused(ctx context.Context, app *apisv1alpha1.Application) error {
+	if app.Status.Status == "Paused" {
+		return nil
+	}
+	app.Status.Status = "Paused"
+	meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               apisv1alpha1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: app.Generation,
+		Reason:             "Paused",
+		Message:            "Reconciliation is paused by the " + PausedAnnotation + " annotation",
+	})
+	return r.status().Update(ctx, app)
+}
+
+// setPending marks the Application as waiting on the provider for the given
+// reason and withdraws its discovery record.
+func (r *ApplicationReconciler) setPending(ctx context.Context, app *apisv1alpha1.Application, reason, message string) error {
+	app.Status.Status = "Pending"
+	meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               apisv1alpha1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: app.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := r.status().Update(ctx, app); err != nil {
+		return err
+	}
+	return r.unpublishNotReady(ctx, app)
+}
+
+// apply creates obj on the provider, or merges its desired state into the
+// existing object as described on mergeDesired. In observe-only
+// mode the provider is only read, and missing objects are recorded on the
+// summary instead.
+func (r *ApplicationReconciler) apply(ctx context.Context, obj client.Object, summary *reconcileSummary) error {
+	if r.ObserveOnly {
+		existing := obj.DeepCopyObject().(client.Object)
+		err := r.ProviderClient.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+		if apierrors.IsNotFound(err) {
+			gvk, err := apiutil.GVKForObject(obj, r.ProviderClient.Scheme())
+			if err != nil {
+				return err
+			}
+			summary.observe(gvk.Kind, client.ObjectKeyFromObject(obj))
+			return nil
+		}
+		return err
+	}
+
+	desired := obj.DeepCopyObject().(client.Object)
+	op, err := controllerutil.CreateOrUpdate(ctx, r.ProviderClient, obj, func() error {
+		mergeDesired(obj, desired)
 		return nil
 	})
 	if err != nil {
-		return ctrl.Result{}, err
+		return err
+	}
+	log.FromContext(ctx).V(1).Info("Applied provider object",
+		"object", client.ObjectKeyFromObject(obj), "type", fmt.Sprintf("%T", obj), "operation", op)
+	summary.record(op)
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&apisv1alpha1.Application{}, builder.WithPredicates(ApplicationPredicate())).
+		Named("application").
+		Complete(r)
+}
+
+// BuildProviderObjects returns the Deployment, Service, ConfigMap and, if
+// requested, Ingress that are provisioned on the provider for app in
+// namespace, given the Database and Cluster it refers to and its database
+// secret. Objects the Application opted out of are left out.
+func BuildProviderObjects(
+	app *apisv1alpha1.Application,
+	naming ProviderNaming,
+	namespace string,
+	db *cnpgapiv1.Database,
+	dbCluster *cnpgapiv1.Cluster,
+	secret corev1.Secret,
+) ([]client.Object, error) {
+	pgpass := newPgpassData(db, dbCluster, secret, namespace)
+	name := naming.Name(app)
+
+	deployment, err := getApplicationDeployment(pgpass, app, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := getApplicationService(app, name, namespace)
+	if err != nil {
+		return nil, err
 	}
 
 	serverJson, err := pgpass.toServersJson()
 	if err != nil {
-		return ctrl.Result{}, err
+		return nil, err
 	}
 
 	serverConfig := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      serverJsonConfigMapName(app),
+			Name:      serverJsonConfigMapName(name),
 			Namespace: namespace,
+			Labels:    managedLabels(app),
 			Finalizers: []string{
 				FinalizerName,
 			},
@@ -151,34 +613,15 @@ func (r *ApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		},
 	}
 
-	_, err = controllerutil.CreateOrUpdate(ctx, r.ProviderClient, serverConfig, func() error {
-		return nil
-	})
-	if err != nil {
-		return ctrl.Result{}, err
+	objs := []client.Object{deployment, svc, serverConfig}
+	if app.Spec.Ingress != nil {
+		objs = append(objs, getApplicationIngress(app, name, namespace))
 	}
-
-	// Update the status
-	app.Status.Status = "Ready"
-	app.Status.ConnectionString = "kubectl port-forward svc/" + app.Name + " 8080:8080 -n " + namespace
-
-	if err := r.Client.Status().Update(ctx, app); err != nil {
-		return ctrl.Result{}, err
-	}
-
-	return ctrl.Result{}, nil
+	return managedObjects(app, objs), nil
 }
 
-// SetupWithManager sets up the controller with the Manager.
-func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&apisv1alpha1.Application{}).
-		Named("application").
-		Complete(r)
-}
-
-func serverJsonConfigMapName(app *apisv1alpha1.Application) string {
-	return fmt.Sprintf("%s-servers", app.Name)
+func serverJsonConfigMapName(name string) string {
+	return fmt.Sprintf("%s-servers", name)
 }
 
 func pgsqlServerHost(db *cnpgapiv1.Database) string {
@@ -245,22 +688,23 @@ func (data *pgpassData) toPassfileContent() string {
 	return sb.String()
 }
 
-func getApplicationService(app *apisv1alpha1.Application, namespace string) (*corev1.Service, error) {
+func getApplicationService(app *apisv1alpha1.Application, name, namespace string) (*corev1.Service, error) {
 	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      app.Name,
+			Name:      name,
 			Namespace: namespace,
+			Labels:    managedLabels(app),
 			Finalizers: []string{
 				FinalizerName,
 			},
 		},
 		Spec: corev1.ServiceSpec{
 			Selector: map[string]string{
-				"app": app.Name,
+				"app": name,
 			},
 			Ports: []corev1.ServicePort{
 				{
-					Port:       8080,
+					Port:       pgAdminPort,
 					TargetPort: intstr.FromInt(80),
 				},
 			},
@@ -271,7 +715,7 @@ func getApplicationService(app *apisv1alpha1.Application, namespace string) (*co
 func getApplicationDeployment(
 	pgpass *pgpassData,
 	app *apisv1alpha1.Application,
-	namespace string,
+	name, namespace string,
 ) (*appsv1.Deployment, error) {
 	const serverConfigVolume = "server-config"
 	const serverSecretVolume = "server-secret"
@@ -280,8 +724,9 @@ func getApplicationDeployment(
 
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      app.Name,
+			Name:      name,
 			Namespace: namespace,
+			Labels:    managedLabels(app),
 			Finalizers: []string{
 				FinalizerName,
 			},
@@ -290,13 +735,13 @@ func getApplicationDeployment(
 			Replicas: ptr.To[int32](1),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{
-					"app": app.Name,
+					"app": name,
 				},
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{
-						"app": app.Name,
+						"app": name,
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -306,7 +751,7 @@ func getApplicationDeployment(
 							VolumeSource: corev1.VolumeSource{
 								ConfigMap: &corev1.ConfigMapVolumeSource{
 									LocalObjectReference: corev1.LocalObjectReference{
-										Name: serverJsonConfigMapName(app),
+										Name: serverJsonConfigMapName(name),
 									},
 								},
 							},
@@ -17,17 +17,32 @@ limitations under the License.
 package controller
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
 	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/audit"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/inventory"
 )
 
 var _ = Describe("Application Controller", func() {
@@ -81,4 +96,337 @@ var _ = Describe("Application Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	Context("When running in observe-only mode", func() {
+		const resourceName = "observed-resource"
+		const providerNamespace = "root:observed"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should report the would-be provider state without writing to the provider", func() {
+			mutations := 0
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					mutations++
+					return c.Create(ctx, obj, opts...)
+				},
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					mutations++
+					return c.Update(ctx, obj, opts...)
+				},
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					mutations++
+					return c.Patch(ctx, obj, patch, opts...)
+				},
+				Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					mutations++
+					return c.Delete(ctx, obj, opts...)
+				},
+			})
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+				ObserveOnly:    true,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mutations).To(BeZero())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Observed"))
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("ObserveOnly"))
+			Expect(cond.Message).To(ContainSubstring("Deployment " + providerNamespace + "/" + resourceName))
+			Expect(cond.Message).To(ContainSubstring("Service " + providerNamespace + "/" + resourceName))
+			Expect(cond.Message).To(ContainSubstring("ConfigMap " + providerNamespace + "/" + resourceName + "-servers"))
+		})
+	})
+
+	Context("When the provider objects were just created", func() {
+		const resourceName = "created-resource"
+		const providerNamespace = "created"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should requeue after the post-create delay", func() {
+			controllerReconciler := &ApplicationReconciler{
+				Client:                 k8sClient,
+				Scheme:                 k8sClient.Scheme(),
+				ProviderClient:         newProviderClient(providerNamespace, interceptor.Funcs{}),
+				PostCreateRequeueDelay: 7 * time.Second,
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(7 * time.Second))
+
+			By("reconciling again once the objects exist")
+			result, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+		})
+	})
+
+	Context("When the global Application capacity is reached", func() {
+		const resourceName = "capacity-resource"
+		const providerNamespace = "capacity"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should hold back new Applications until capacity frees up", func() {
+			applications := inventory.New()
+			for _, workspace := range []string{"team-a", "team-b"} {
+				applications.Set(inventory.Record{Workspace: workspace, Namespace: "default", Name: "app", Provisioned: true})
+			}
+			creates := 0
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					creates++
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:               k8sClient,
+				Scheme:               k8sClient.Scheme(),
+				ProviderClient:       providerClient,
+				Workspace:            "team-c",
+				Inventory:            applications,
+				MaxTotalApplications: 2,
+			}
+
+			By("reconciling the third Application")
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(capacityRequeueInterval))
+			Expect(creates).To(BeZero())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("GlobalCapacityExceeded"))
+			Expect(applications.CountProvisioned()).To(Equal(2))
+
+			By("removing one of the provisioned Applications")
+			applications.Delete("team-a", types.NamespacedName{Namespace: "default", Name: "app"})
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(creates).To(Equal(3))
+			Expect(applications.CountProvisioned()).To(Equal(2))
+
+			By("keeping an already provisioned Application at capacity")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Ready"))
+		})
+	})
+
+	Context("When auditing provider mutations", func() {
+		const resourceName = "audit-resource"
+		const providerNamespace = "audit"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should attribute every provider mutation to the Application", func() {
+			var trail bytes.Buffer
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: audit.NewClient(newProviderClient(providerNamespace, interceptor.Funcs{}), &trail),
+				Workspace:      "root:team-a",
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			var kinds []string
+			scanner := bufio.NewScanner(&trail)
+			for scanner.Scan() {
+				var entry audit.Entry
+				Expect(json.Unmarshal(scanner.Bytes(), &entry)).To(Succeed())
+				Expect(entry.Workspace).To(Equal("root:team-a"))
+				Expect(entry.Application).To(Equal(typeNamespacedName.String()))
+				Expect(entry.Verb).To(Equal("create"))
+				Expect(entry.Result).To(Equal(audit.ResultSuccess))
+				Expect(entry.Namespace).To(Equal(providerNamespace))
+				kinds = append(kinds, entry.Kind)
+			}
+			Expect(kinds).To(ConsistOf("Deployment", "Service", "ConfigMap"))
+		})
+	})
 })
+
+// createApplicationFixture creates an Application targeting providerNamespace
+// together with the database secret it references.
+func createApplicationFixture(ctx context.Context, key types.NamespacedName, providerNamespace string) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name + "-db",
+			Namespace: key.Namespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("app"),
+			"password": []byte("secret"),
+		},
+	}
+	Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+	app := &apisv1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Annotations: map[string]string{
+				"kcp.io/cluster": providerNamespace,
+			},
+		},
+		Spec: apisv1alpha1.ApplicationSpec{
+			DatabaseRef:       "db",
+			DatabaseSecretRef: corev1.SecretReference{Name: secret.Name},
+		},
+	}
+	Expect(k8sClient.Create(ctx, app)).To(Succeed())
+}
+
+func deleteApplicationFixture(ctx context.Context, key types.NamespacedName) {
+	app := &apisv1alpha1.Application{}
+	Expect(k8sClient.Get(ctx, key, app)).To(Succeed())
+	Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+	if err := k8sClient.Get(ctx, key, app); err == nil && len(app.Finalizers) > 0 {
+		app.Finalizers = nil
+		Expect(k8sClient.Update(ctx, app)).To(Succeed())
+	}
+
+	secret := &corev1.Secret{}
+	Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: key.Namespace, Name: key.Name + "-db"}, secret)).To(Succeed())
+	Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+}
+
+// newProviderClient returns a fake provider client seeded with objs, the
+// provider namespace unless objs contain it, and the Database and Cluster an
+// Application fixture in the given namespace refers to.
+func newProviderClient(namespace string, funcs interceptor.Funcs, objs ...client.Object) client.WithWatch {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(cnpgapiv1.AddToScheme(scheme)).To(Succeed())
+
+	seedNamespace := true
+	for _, obj := range objs {
+		if ns, ok := obj.(*corev1.Namespace); ok && ns.Name == namespace {
+			seedNamespace = false
+		}
+	}
+	if seedNamespace {
+		objs = append(objs, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+	}
+
+	db := &cnpgapiv1.Database{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: namespace,
+		},
+		Spec: cnpgapiv1.DatabaseSpec{
+			Name:       "app",
+			Owner:      "app",
+			ClusterRef: corev1.LocalObjectReference{Name: "pg"},
+		},
+	}
+	cluster := &cnpgapiv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pg",
+			Namespace: namespace,
+		},
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(objs, db, cluster)...).
+		WithInterceptorFuncs(funcs).
+		Build()
+}
+
+// statusCountingClient counts the status writes issued through it.
+type statusCountingClient struct {
+	client.Client
+	updates, patches int
+}
+
+func (c *statusCountingClient) Status() client.SubResourceWriter {
+	return &statusCountingWriter{SubResourceWriter: c.Client.Status(), client: c}
+}
+
+type statusCountingWriter struct {
+	client.SubResourceWriter
+	client *statusCountingClient
+}
+
+func (w *statusCountingWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	w.client.updates++
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func (w *statusCountingWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	w.client.patches++
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
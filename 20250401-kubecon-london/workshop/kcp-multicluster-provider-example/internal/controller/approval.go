@@ -0,0 +1,63 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// approvalRequeueInterval is how often an Application pending approval is
+// checked again.
+const approvalRequeueInterval = 30 * time.Second
+
+// ApprovalSource names the ConfigMap in the workspace that approves
+// provisioning. The zero value approves every Application.
+type ApprovalSource struct {
+	// ConfigMapName is the ConfigMap in the Application's namespace whose
+	// existence approves provisioning.
+	ConfigMapName string
+	// Key, if set, must be "true" in the ConfigMap data for provisioning to
+	// be approved.
+	Key string
+}
+
+// isApproved reports whether provisioning app has been approved.
+func (r *ApplicationReconciler) isApproved(ctx context.Context, app *apisv1alpha1.Application) (bool, error) {
+	if r.Approval.ConfigMapName == "" {
+		return true, nil
+	}
+
+	var cm corev1.ConfigMap
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: r.Approval.ConfigMapName}, &cm)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if r.Approval.Key == "" {
+		return true, nil
+	}
+	return cm.Data[r.Approval.Key] == "true", nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When provisioning requires approval", func() {
+		const resourceName = "approval-resource"
+		const providerNamespace = "approval"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+			approval := &corev1.ConfigMap{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "provisioning-approved"}, approval); err == nil {
+				Expect(k8sClient.Delete(ctx, approval)).To(Succeed())
+			}
+		})
+
+		It("should block provisioning until the approval ConfigMap exists", func() {
+			mutations := 0
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					mutations++
+					return c.Create(ctx, obj, opts...)
+				},
+			})
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+				Approval:       ApprovalSource{ConfigMapName: "provisioning-approved"},
+			}
+
+			By("reconciling without approval")
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(approvalRequeueInterval))
+
+			By("capping the requeue interval")
+			controllerReconciler.MaxRequeueInterval = 5 * time.Second
+			result, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(5 * time.Second))
+			controllerReconciler.MaxRequeueInterval = 0
+			Expect(mutations).To(BeZero())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("PendingApproval"))
+
+			By("approving the Application")
+			Expect(k8sClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "provisioning-approved", Namespace: "default"},
+			})).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mutations).To(Equal(3))
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Ready"))
+		})
+	})
+})
@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// baseRetryDelay is the backoff after the first failed reconcile, as in the
+// controller-runtime default rate limiter.
+const baseRetryDelay = 5 * time.Millisecond
+
+// NewRateLimiter returns the rate limiter for Applications whose reconcile
+// failed with a retriable error. It backs off exponentially per Application
+// up to maxDelay, and limits the overall retry rate like the default
+// controller rate limiter does. A non-positive maxDelay returns the default.
+func NewRateLimiter[T comparable](maxDelay time.Duration) workqueue.TypedRateLimiter[T] {
+	if maxDelay <= 0 {
+		return workqueue.DefaultTypedControllerRateLimiter[T]()
+	}
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[T](baseRetryDelay, maxDelay),
+		&workqueue.TypedBucketRateLimiter[T]{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+}
+
+// capRequeue caps the RequeueAfter of result at maxDelay, if set.
+func capRequeue(result ctrl.Result, maxDelay time.Duration) ctrl.Result {
+	if maxDelay > 0 && result.RequeueAfter > maxDelay {
+		result.RequeueAfter = maxDelay
+	}
+	return result
+}
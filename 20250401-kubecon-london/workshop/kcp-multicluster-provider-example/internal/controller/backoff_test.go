@@ -0,0 +1,58 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("NewRateLimiter", func() {
+	It("should cap the backoff of repeatedly failing Applications", func() {
+		limiter := NewRateLimiter[string](5 * time.Minute)
+
+		var delay time.Duration
+		for range 30 {
+			delay = limiter.When("app")
+		}
+		Expect(delay).To(Equal(5 * time.Minute))
+
+		limiter.Forget("app")
+		Expect(limiter.When("app")).To(Equal(baseRetryDelay))
+	})
+
+	It("should keep the default backoff without a cap", func() {
+		limiter := NewRateLimiter[string](0)
+
+		var delay time.Duration
+		for range 30 {
+			delay = limiter.When("app")
+		}
+		Expect(delay).To(Equal(1000 * time.Second))
+	})
+})
+
+var _ = Describe("capRequeue", func() {
+	It("should cap RequeueAfter at the maximum", func() {
+		Expect(capRequeue(ctrl.Result{RequeueAfter: time.Hour}, time.Minute).RequeueAfter).To(Equal(time.Minute))
+		Expect(capRequeue(ctrl.Result{RequeueAfter: time.Second}, time.Minute).RequeueAfter).To(Equal(time.Second))
+		Expect(capRequeue(ctrl.Result{RequeueAfter: time.Hour}, 0).RequeueAfter).To(Equal(time.Hour))
+	})
+})
@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// lastReconcileTimeResolution is how often LastReconcileTime is refreshed
+// on the status-only path when nothing else changed.
+const lastReconcileTimeResolution = 5 * time.Minute
+
+// touchLastReconcileTime sets LastReconcileTime to now unless it was set
+// less than lastReconcileTimeResolution ago. It reports whether it did.
+func touchLastReconcileTime(app *apisv1alpha1.Application, now metav1.Time) bool {
+	last := app.Status.LastReconcileTime
+	if last != nil && now.Sub(last.Time) < lastReconcileTimeResolution {
+		return false
+	}
+	app.Status.LastReconcileTime = &now
+	return true
+}
+
+// mirroredClusterConditions maps the CNPG Cluster condition types that are
+// copied onto the Application status to their Application condition type.
+// The set is deliberately small so the Application status stays bounded.
+var mirroredClusterConditions = map[string]string{
+	string(cnpgapiv1.ConditionClusterReady): apisv1alpha1.ConditionClusterReady,
+}
+
+// mirrorClusterStatus copies the phase and the mirrored conditions of
+// cluster onto the Application status. It reports whether the status
+// changed.
+func mirrorClusterStatus(app *apisv1alpha1.Application, cluster *cnpgapiv1.Cluster) bool {
+	changed := app.Status.ClusterPhase != cluster.Status.Phase
+	app.Status.ClusterPhase = cluster.Status.Phase
+
+	for clusterType, appType := range mirroredClusterConditions {
+		cond := meta.FindStatusCondition(cluster.Status.Conditions, clusterType)
+		if cond == nil {
+			if meta.RemoveStatusCondition(&app.Status.Conditions, appType) {
+				changed = true
+			}
+			continue
+		}
+		reason := cond.Reason
+		if reason == "" {
+			reason = "ClusterCondition"
+		}
+		if meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type:               appType,
+			Status:             cond.Status,
+			ObservedGeneration: app.Generation,
+			Reason:             reason,
+			Message:            cond.Message,
+		}) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// refreshClusterStatus re-reads the CNPG Cluster of an up to date
+// Application and patches the mirrored status if it changed or
+// LastReconcileTime is due. This is the status-only path: it skips the
+// provisioning logic and only sends the changed fields, so it does not
+// conflict with concurrent writers.
+func (r *ApplicationReconciler) refreshClusterStatus(
+	ctx context.Context, app *apisv1alpha1.Application, namespace string, summary *reconcileSummary,
+) error {
+	var db cnpgapiv1.Database
+	if err := r.ProviderClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: app.Spec.DatabaseRef}, &db); err != nil {
+		return err
+	}
+	var dbCluster cnpgapiv1.Cluster
+	if err := r.ProviderClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: db.GetClusterRef().Name}, &dbCluster); err != nil {
+		return err
+	}
+	summary.cluster = dbCluster.Name
+	// CA rotations do not change the spec, so they are picked up here too.
+	if r.PropagateServerCA && !r.ObserveOnly {
+		if err := r.propagateServerCA(ctx, app, &dbCluster, namespace); err != nil {
+			return err
+		}
+	}
+
+	base := app.DeepCopy()
+	now := metav1.Now()
+	changed := mirrorClusterStatus(app, &dbCluster)
+	recordConditionMilestones(app, base.Status.Conditions, now)
+	if clearFailureHistory(app, now) {
+		changed = true
+	}
+	if !touchLastReconcileTime(app, now) && !changed {
+		return nil
+	}
+	return r.status().Patch(ctx, app, client.MergeFrom(base))
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When the provider Cluster reports its status", func() {
+		const resourceName = "phase-resource"
+		const providerNamespace = "phase"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		setClusterStatus := func(providerClient client.Client, phase string, ready metav1.ConditionStatus) {
+			cluster := &cnpgapiv1.Cluster{}
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: "pg"}, cluster)).To(Succeed())
+			cluster.Status.Phase = phase
+			meta.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+				Type:    string(cnpgapiv1.ConditionClusterReady),
+				Status:  ready,
+				Reason:  "ClusterIsReady",
+				Message: "Cluster is Ready",
+			})
+			Expect(providerClient.Update(ctx, cluster)).To(Succeed())
+		}
+
+		It("should record the provisioning milestones in order", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			milestones := func() []string {
+				app := &apisv1alpha1.Application{}
+				Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+				var names []string
+				for _, event := range app.Status.Timeline {
+					Expect(event.Time.IsZero()).To(BeFalse())
+					names = append(names, event.Milestone)
+				}
+				return names
+			}
+
+			By("provisioning the Application")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(milestones()).To(Equal([]string{
+				apisv1alpha1.MilestoneProviderObjectsCreated,
+				apisv1alpha1.MilestoneReady,
+			}))
+
+			By("the Cluster becoming ready")
+			setClusterStatus(providerClient, cnpgapiv1.PhaseHealthy, metav1.ConditionTrue)
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(milestones()).To(Equal([]string{
+				apisv1alpha1.MilestoneProviderObjectsCreated,
+				apisv1alpha1.MilestoneReady,
+				apisv1alpha1.MilestoneClusterReady,
+			}))
+
+			By("reconciling again without transitions")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(milestones()).To(HaveLen(3))
+		})
+
+		It("should mirror the Cluster phase and Ready condition", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			setClusterStatus(providerClient, cnpgapiv1.PhaseHealthy, metav1.ConditionTrue)
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.ClusterPhase).To(Equal(cnpgapiv1.PhaseHealthy))
+			Expect(meta.IsStatusConditionTrue(app.Status.Conditions, apisv1alpha1.ConditionClusterReady)).To(BeTrue())
+
+			By("refreshing the status once the spec is up to date")
+			setClusterStatus(providerClient, cnpgapiv1.PhaseUpgrade, metav1.ConditionFalse)
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.ClusterPhase).To(Equal(cnpgapiv1.PhaseUpgrade))
+			Expect(meta.IsStatusConditionFalse(app.Status.Conditions, apisv1alpha1.ConditionClusterReady)).To(BeTrue())
+		})
+	})
+
+	Context("When only the status needs refreshing", func() {
+		const resourceName = "status-only-resource"
+		const providerNamespace = "status-only"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should use fewer API calls and a patch instead of an update", func() {
+			providerCalls := 0
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					providerCalls++
+					return c.Get(ctx, key, obj, opts...)
+				},
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					providerCalls++
+					return c.Create(ctx, obj, opts...)
+				},
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					providerCalls++
+					return c.List(ctx, list, opts...)
+				},
+			})
+			workspaceClient := &statusCountingClient{Client: k8sClient}
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         workspaceClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			By("running a full reconcile")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			fullProviderCalls := providerCalls
+			Expect(workspaceClient.updates).To(Equal(1))
+			Expect(workspaceClient.patches).To(BeZero())
+
+			By("running a status-only reconcile after the Cluster became ready")
+			cluster := &cnpgapiv1.Cluster{}
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: "pg"}, cluster)).To(Succeed())
+			cluster.Status.Phase = cnpgapiv1.PhaseHealthy
+			Expect(providerClient.Update(ctx, cluster)).To(Succeed())
+
+			providerCalls, workspaceClient.updates, workspaceClient.patches = 0, 0, 0
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providerCalls).To(BeNumerically("<", fullProviderCalls))
+			Expect(workspaceClient.updates).To(BeZero())
+			Expect(workspaceClient.patches).To(Equal(1))
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.ClusterPhase).To(Equal(cnpgapiv1.PhaseHealthy))
+
+			By("not writing at all when nothing changed")
+			workspaceClient.updates, workspaceClient.patches = 0, 0
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceClient.updates + workspaceClient.patches).To(BeZero())
+		})
+
+		It("should refresh the last reconcile time once it is due", func() {
+			workspaceClient := &statusCountingClient{Client: k8sClient}
+			controllerReconciler := &ApplicationReconciler{
+				Client:         workspaceClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: newProviderClient(providerNamespace, interceptor.Funcs{}),
+			}
+
+			By("running a full reconcile")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.LastReconcileTime).NotTo(BeNil())
+
+			By("reconciling again within the resolution")
+			workspaceClient.updates, workspaceClient.patches = 0, 0
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceClient.updates + workspaceClient.patches).To(BeZero())
+
+			By("reconciling again once the last reconcile time is stale")
+			stale := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			app.Status.LastReconcileTime = &stale
+			Expect(k8sClient.Status().Update(ctx, app)).To(Succeed())
+
+			workspaceClient.updates, workspaceClient.patches = 0, 0
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(workspaceClient.patches).To(Equal(1))
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.LastReconcileTime.After(stale.Time)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,50 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// NameCollision returns the namespace/name of an existing Application in the
+// workspace whose provider objects would get the same names as those of app,
+// or an empty string if there is none. Applications of the same name in
+// different namespaces collide unless Naming is unique.
+func (r *ApplicationReconciler) NameCollision(ctx context.Context, app *apisv1alpha1.Application) (string, error) {
+	namespace, ok := r.providerNamespace(app)
+	if !ok {
+		return "", nil
+	}
+	name := r.Naming.Name(app)
+
+	var apps apisv1alpha1.ApplicationList
+	if err := r.Client.List(ctx, &apps); err != nil {
+		return "", err
+	}
+	for i := range apps.Items {
+		other := &apps.Items[i]
+		if other.Namespace == app.Namespace && other.Name == app.Name {
+			continue
+		}
+		if otherNamespace, ok := r.providerNamespace(other); ok && otherNamespace == namespace && r.Naming.Name(other) == name {
+			return other.Namespace + "/" + other.Name, nil
+		}
+	}
+	return "", nil
+}
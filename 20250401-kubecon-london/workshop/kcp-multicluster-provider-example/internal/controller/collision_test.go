@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When checking for provider name collisions", func() {
+		ctx := context.Background()
+
+		existing := &apisv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "collide",
+				Namespace:   "default",
+				Annotations: map[string]string{"kcp.io/cluster": "collisions"},
+			},
+		}
+
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, existing.DeepCopy())).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, existing.DeepCopy())).To(Succeed())
+		})
+
+		candidate := func(namespace, name, workspace string) *apisv1alpha1.Application {
+			return &apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Annotations: map[string]string{"kcp.io/cluster": workspace},
+			}}
+		}
+
+		It("should report an Application provisioned under the same names", func() {
+			checker := &ApplicationReconciler{Client: k8sClient}
+
+			collision, err := checker.NameCollision(ctx, candidate("team-b", "collide", "collisions"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collision).To(Equal("default/collide"))
+
+			By("accepting a distinct name")
+			collision, err = checker.NameCollision(ctx, candidate("team-b", "distinct", "collisions"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collision).To(BeEmpty())
+
+			By("accepting the same name in another provider namespace")
+			collision, err = checker.NameCollision(ctx, candidate("team-b", "collide", "elsewhere"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collision).To(BeEmpty())
+
+			By("accepting the same name with unique naming")
+			checker.Naming.Unique = true
+			collision, err = checker.NameCollision(ctx, candidate("team-b", "collide", "collisions"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collision).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,59 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+)
+
+const (
+	// connectivityTimeout bounds a single connectivity check.
+	connectivityTimeout = 3 * time.Second
+	// connectivityRequeueInterval is how often an Application whose database
+	// is unreachable is checked again.
+	connectivityRequeueInterval = 30 * time.Second
+	// postgresPort is the port of the CNPG read-write service.
+	postgresPort = 5432
+)
+
+// DialFunc opens a network connection, like net.Dialer.DialContext.
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// databaseAddress returns the address of the read-write service of the
+// Cluster backing db.
+func databaseAddress(db *cnpgapiv1.Database) string {
+	return net.JoinHostPort(pgsqlServerHost(db), strconv.Itoa(postgresPort))
+}
+
+// verifyConnectivity dials the read-write service of the Cluster backing
+// db. This catches network policies that block the database even though
+// CNPG reports the Cluster as healthy.
+func (r *ApplicationReconciler) verifyConnectivity(ctx context.Context, db *cnpgapiv1.Database) error {
+	ctx, cancel := context.WithTimeout(ctx, connectivityTimeout)
+	defer cancel()
+
+	conn, err := r.ConnectivityDialer(ctx, "tcp", databaseAddress(db))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When verifying the database connectivity", func() {
+		const resourceName = "connectivity-resource"
+		const providerNamespace = "connectivity"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should only mark the Application Ready once the database is reachable", func() {
+			var dialed []string
+			reachable := false
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: newProviderClient(providerNamespace, interceptor.Funcs{}),
+				ConnectivityDialer: func(_ context.Context, network, address string) (net.Conn, error) {
+					dialed = append(dialed, network+"://"+address)
+					if !reachable {
+						return nil, stderrors.New("i/o timeout")
+					}
+					conn, peer := net.Pipe()
+					Expect(peer.Close()).To(Succeed())
+					return conn, nil
+				},
+			}
+
+			By("reconciling while a network policy blocks the database")
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(connectivityRequeueInterval))
+			Expect(dialed).To(Equal([]string{"tcp://pg-rw." + providerNamespace + ".svc.cluster.local:5432"}))
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Pending"))
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("DatabaseUnreachable"))
+			Expect(cond.Message).To(ContainSubstring("i/o timeout"))
+
+			By("reconciling once the database is reachable")
+			reachable = true
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Ready"))
+		})
+	})
+})
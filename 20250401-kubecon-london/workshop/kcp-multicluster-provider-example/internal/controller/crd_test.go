@@ -0,0 +1,84 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	kcpapisv1alpha1 "github.com/kcp-dev/kcp/sdk/apis/apis/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Application CRD", func() {
+	readYAML := func(path string, into any) {
+		data, err := os.ReadFile(filepath.Join("..", "..", "config", path))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(yaml.Unmarshal(data, into)).To(Succeed())
+	}
+
+	expectedColumns := []apiextensionsv1.CustomResourceColumnDefinition{
+		{Name: "Ready", Type: "string", JSONPath: `.status.conditions[?(@.type=="Ready")].status`},
+		{Name: "Endpoint", Type: "string", JSONPath: ".status.connectionString"},
+		{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+	}
+
+	It("should have short names, a category and printer columns", func() {
+		var crd apiextensionsv1.CustomResourceDefinition
+		readYAML(filepath.Join("crd", "bases", "apis.contrib.kcp.io_applications.yaml"), &crd)
+
+		Expect(crd.Spec.Names.ShortNames).To(ConsistOf("app", "apps-db"))
+		Expect(crd.Spec.Names.Categories).To(ConsistOf("kcp"))
+		Expect(crd.Spec.Versions).To(HaveLen(1))
+		Expect(crd.Spec.Versions[0].AdditionalPrinterColumns).To(Equal(expectedColumns))
+	})
+
+	It("should match in the kcp APIResourceSchema", func() {
+		var schema kcpapisv1alpha1.APIResourceSchema
+		readYAML(filepath.Join("kcp", "apiresourceschema-applications.apis.contrib.kcp.io.yaml"), &schema)
+
+		Expect(schema.Spec.Names.ShortNames).To(ConsistOf("app", "apps-db"))
+		Expect(schema.Spec.Names.Categories).To(ConsistOf("kcp"))
+		Expect(schema.Spec.Versions).To(HaveLen(1))
+		Expect(schema.Spec.Versions[0].AdditionalPrinterColumns).To(Equal(expectedColumns))
+	})
+
+	It("should be served by the kcp APIExport as generated from the CRD", func() {
+		var crd apiextensionsv1.CustomResourceDefinition
+		readYAML(filepath.Join("crd", "bases", "apis.contrib.kcp.io_applications.yaml"), &crd)
+		var schema kcpapisv1alpha1.APIResourceSchema
+		readYAML(filepath.Join("kcp", "apiresourceschema-applications.apis.contrib.kcp.io.yaml"), &schema)
+		var export kcpapisv1alpha1.APIExport
+		readYAML(filepath.Join("kcp", "apiexport-apis.contrib.kcp.io.yaml"), &export)
+
+		prefix, _, _ := strings.Cut(schema.Name, ".")
+		generated, err := kcpapisv1alpha1.CRDToAPIResourceSchema(&crd, prefix)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schema.Name).To(Equal(generated.Name))
+		Expect(schema.Spec.Versions).To(HaveLen(len(generated.Spec.Versions)))
+		for i := range generated.Spec.Versions {
+			Expect(schema.Spec.Versions[i].Schema.Raw).To(MatchJSON(generated.Spec.Versions[i].Schema.Raw),
+				"the APIResourceSchema is stale, regenerate it with make kcp-generate")
+		}
+
+		Expect(export.Spec.LatestResourceSchemas).To(ContainElement(schema.Name))
+	})
+})
@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// pgAdminPort is the port of the pgAdmin Service.
+const pgAdminPort = 8080
+
+// discoveryEntry is the discovery record of a Ready Application.
+type discoveryEntry struct {
+	Workspace string `json:"workspace,omitempty"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Database is the address of the read-write database service.
+	Database string `json:"database"`
+	// PgAdmin is the address of the pgAdmin service.
+	PgAdmin string `json:"pgadmin"`
+}
+
+// discoveryKey returns the key of the discovery record of app. Workspace
+// paths contain colons, which are not allowed in ConfigMap keys.
+func (r *ApplicationReconciler) discoveryKey(app *apisv1alpha1.Application) string {
+	key := app.Namespace + "." + app.Name
+	if r.Workspace != "" {
+		key = strings.ReplaceAll(r.Workspace, ":", "_") + "." + key
+	}
+	return key
+}
+
+// publishDiscovery records the endpoints of the Ready app in the discovery
+// ConfigMap, if one is configured.
+func (r *ApplicationReconciler) publishDiscovery(ctx context.Context, app *apisv1alpha1.Application, db *cnpgapiv1.Database, namespace string) error {
+	if r.DiscoveryConfigMap.Name == "" {
+		return nil
+	}
+	value, err := json.Marshal(discoveryEntry{
+		Workspace: r.Workspace,
+		Namespace: app.Namespace,
+		Name:      app.Name,
+		Database:  databaseAddress(db),
+		PgAdmin:   net.JoinHostPort(r.Naming.Name(app)+"."+namespace+".svc.cluster.local", strconv.Itoa(pgAdminPort)),
+	})
+	if err != nil {
+		return err
+	}
+
+	key := r.discoveryKey(app)
+	return r.updateDiscovery(ctx, func(data map[string]string) bool {
+		if data[key] == string(value) {
+			return false
+		}
+		data[key] = string(value)
+		return true
+	})
+}
+
+// unpublishDiscovery removes the discovery record of app, if a discovery
+// ConfigMap is configured.
+func (r *ApplicationReconciler) unpublishDiscovery(ctx context.Context, app *apisv1alpha1.Application) error {
+	if r.DiscoveryConfigMap.Name == "" {
+		return nil
+	}
+	key := r.discoveryKey(app)
+	return r.updateDiscovery(ctx, func(data map[string]string) bool {
+		if _, ok := data[key]; !ok {
+			return false
+		}
+		delete(data, key)
+		return true
+	})
+}
+
+// unpublishNotReady removes the discovery record of app once it is no
+// longer Ready. In observe-only mode the provider is left untouched.
+func (r *ApplicationReconciler) unpublishNotReady(ctx context.Context, app *apisv1alpha1.Application) error {
+	if r.ObserveOnly {
+		return nil
+	}
+	return r.unpublishDiscovery(ctx, app)
+}
+
+// updateDiscovery applies mutate to the data of the discovery ConfigMap and
+// writes it back if mutate reports a change. The ConfigMap is shared by all
+// Applications, so conflicting writes are retried.
+func (r *ApplicationReconciler) updateDiscovery(ctx context.Context, mutate func(map[string]string) bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var cm corev1.ConfigMap
+		err := r.ProviderClient.Get(ctx, r.DiscoveryConfigMap, &cm)
+		if apierrors.IsNotFound(err) {
+			cm = corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      r.DiscoveryConfigMap.Name,
+					Namespace: r.DiscoveryConfigMap.Namespace,
+					Labels:    map[string]string{ManagedByLabel: managedByValue},
+				},
+				Data: map[string]string{},
+			}
+			if !mutate(cm.Data) {
+				return nil
+			}
+			return r.ProviderClient.Create(ctx, &cm)
+		}
+		if err != nil {
+			return err
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		if !mutate(cm.Data) {
+			return nil
+		}
+		return r.ProviderClient.Update(ctx, &cm)
+	})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When publishing discovery records", func() {
+		const resourceName = "discovery-resource"
+		const providerNamespace = "discovery"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		discoveryKey := types.NamespacedName{Namespace: "platform", Name: "databases"}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		It("should keep the discovery record in sync with the Application", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:             k8sClient,
+				Scheme:             k8sClient.Scheme(),
+				ProviderClient:     providerClient,
+				Workspace:          "root:team-a",
+				DiscoveryConfigMap: discoveryKey,
+			}
+			recordKey := "root_team-a.default." + resourceName
+
+			By("provisioning the Application")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			discovery := &corev1.ConfigMap{}
+			Expect(providerClient.Get(ctx, discoveryKey, discovery)).To(Succeed())
+			Expect(discovery.Data).To(HaveKey(recordKey))
+			var entry map[string]string
+			Expect(json.Unmarshal([]byte(discovery.Data[recordKey]), &entry)).To(Succeed())
+			Expect(entry).To(Equal(map[string]string{
+				"workspace": "root:team-a",
+				"namespace": "default",
+				"name":      resourceName,
+				"database":  "pg-rw." + providerNamespace + ".svc.cluster.local:5432",
+				"pgadmin":   resourceName + "." + providerNamespace + ".svc.cluster.local:8080",
+			}))
+
+			By("keeping records of other Applications")
+			discovery.Data["root_team-b.default.other"] = "{}"
+			Expect(providerClient.Update(ctx, discovery)).To(Succeed())
+
+			By("updating the record when the endpoint changes")
+			controllerReconciler.Naming = ProviderNaming{Prefix: "pga-"}
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			app.Annotations[ForceReconcileAnnotation] = "rename"
+			Expect(k8sClient.Update(ctx, app)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerClient.Get(ctx, discoveryKey, discovery)).To(Succeed())
+			Expect(discovery.Data[recordKey]).To(ContainSubstring(`"pgadmin":"pga-` + resourceName + "."))
+
+			By("deleting the Application")
+			controllerReconciler.Naming = ProviderNaming{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerClient.Get(ctx, discoveryKey, discovery)).To(Succeed())
+			Expect(discovery.Data).NotTo(HaveKey(recordKey))
+			Expect(discovery.Data).To(HaveKey("root_team-b.default.other"))
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: resourceName + "-db"}, secret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		})
+
+		It("should remove the discovery record once the Application is no longer Ready", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:             k8sClient,
+				Scheme:             k8sClient.Scheme(),
+				ProviderClient:     providerClient,
+				DiscoveryConfigMap: discoveryKey,
+			}
+			recordKey := "default." + resourceName
+
+			By("provisioning the Application")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			discovery := &corev1.ConfigMap{}
+			Expect(providerClient.Get(ctx, discoveryKey, discovery)).To(Succeed())
+			Expect(discovery.Data).To(HaveKey(recordKey))
+
+			By("deleting its database secret")
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: resourceName + "-db"}, secret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+			controllerReconciler.Forced = true
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Pending"))
+			Expect(providerClient.Get(ctx, discoveryKey, discovery)).To(Succeed())
+			Expect(discovery.Data).NotTo(HaveKey(recordKey))
+
+			Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+			if err := k8sClient.Get(ctx, typeNamespacedName, app); err == nil && len(app.Finalizers) > 0 {
+				app.Finalizers = nil
+				Expect(k8sClient.Update(ctx, app)).To(Succeed())
+			}
+		})
+	})
+})
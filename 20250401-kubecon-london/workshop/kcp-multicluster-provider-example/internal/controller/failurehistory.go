@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// failureHistoryRetention is how long an Application has to stay Ready
+// before its failure history is cleared.
+const failureHistoryRetention = time.Hour
+
+// recordFailure appends a failure to the history of app unless it is
+// already failed for the same reason, and drops the oldest failures beyond
+// MaxFailureHistory. It has to be called before the Ready condition is
+// updated.
+func recordFailure(app *apisv1alpha1.Application, reason, message string, now metav1.Time) {
+	ready := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+	if app.Status.Status == "Failed" && ready != nil && ready.Reason == reason {
+		return
+	}
+	app.Status.FailureHistory = append(app.Status.FailureHistory,
+		apisv1alpha1.FailureEvent{Reason: reason, Message: message, Time: now})
+	if excess := len(app.Status.FailureHistory) - apisv1alpha1.MaxFailureHistory; excess > 0 {
+		app.Status.FailureHistory = app.Status.FailureHistory[excess:]
+	}
+}
+
+// clearFailureHistory clears the failure history of app once it has been
+// Ready for failureHistoryRetention. It reports whether anything was
+// cleared.
+func clearFailureHistory(app *apisv1alpha1.Application, now metav1.Time) bool {
+	if len(app.Status.FailureHistory) == 0 {
+		return false
+	}
+	ready := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+	if ready == nil || ready.Status != metav1.ConditionTrue || now.Sub(ready.LastTransitionTime.Time) < failureHistoryRetention {
+		return false
+	}
+	app.Status.FailureHistory = nil
+	return true
+}
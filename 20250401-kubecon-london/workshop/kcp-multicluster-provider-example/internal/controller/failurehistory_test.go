@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Failure history", func() {
+	fail := func(app *apisv1alpha1.Application, reason string) {
+		recordFailure(app, reason, reason+" happened", metav1.Now())
+		app.Status.Status = "Failed"
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type: apisv1alpha1.ConditionReady, Status: metav1.ConditionFalse, Reason: reason,
+		})
+	}
+	succeed := func(app *apisv1alpha1.Application, since time.Time) {
+		app.Status.Status = "Ready"
+		meta.RemoveStatusCondition(&app.Status.Conditions, apisv1alpha1.ConditionReady)
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type: apisv1alpha1.ConditionReady, Status: metav1.ConditionTrue, Reason: "Provisioned",
+			LastTransitionTime: metav1.NewTime(since),
+		})
+	}
+
+	It("should record each failed transition once", func() {
+		app := &apisv1alpha1.Application{}
+		fail(app, "ProviderRejected")
+		fail(app, "ProviderRejected")
+		fail(app, "ProviderQuotaExceeded")
+
+		Expect(app.Status.FailureHistory).To(HaveLen(2))
+		Expect(app.Status.FailureHistory[0].Reason).To(Equal("ProviderRejected"))
+		Expect(app.Status.FailureHistory[0].Message).To(Equal("ProviderRejected happened"))
+		Expect(app.Status.FailureHistory[1].Reason).To(Equal("ProviderQuotaExceeded"))
+
+		By("recording a flapping Application every time it fails again")
+		succeed(app, time.Now())
+		fail(app, "ProviderRejected")
+		Expect(app.Status.FailureHistory).To(HaveLen(3))
+	})
+
+	It("should keep only the most recent failures", func() {
+		app := &apisv1alpha1.Application{}
+		for range apisv1alpha1.MaxFailureHistory + 3 {
+			fail(app, "ProviderRejected")
+			succeed(app, time.Now())
+		}
+		fail(app, "SecretNotFound")
+
+		Expect(app.Status.FailureHistory).To(HaveLen(apisv1alpha1.MaxFailureHistory))
+		Expect(app.Status.FailureHistory[apisv1alpha1.MaxFailureHistory-1].Reason).To(Equal("SecretNotFound"))
+	})
+
+	It("should clear the history after sustained success", func() {
+		app := &apisv1alpha1.Application{}
+		fail(app, "ProviderRejected")
+
+		succeed(app, time.Now())
+		Expect(clearFailureHistory(app, metav1.Now())).To(BeFalse())
+		Expect(app.Status.FailureHistory).To(HaveLen(1))
+
+		succeed(app, time.Now().Add(-failureHistoryRetention))
+		Expect(clearFailureHistory(app, metav1.Now())).To(BeTrue())
+		Expect(app.Status.FailureHistory).To(BeEmpty())
+	})
+})
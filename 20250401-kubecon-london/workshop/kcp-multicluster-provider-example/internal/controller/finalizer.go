@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	// ManagedByLabel marks provider objects created by this controller.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	// ApplicationLabel names the Application a provider object belongs to.
+	ApplicationLabel = "apis.contrib.kcp.io/application"
+
+	managedByValue = "application-controller"
+)
+
+// managedLabels returns the management labels set on every provider object
+// of app.
+func managedLabels(app *apisv1alpha1.Application) map[string]string {
+	return map[string]string{
+		ManagedByLabel:   managedByValue,
+		ApplicationLabel: app.Name,
+	}
+}
+
+// providerObjects returns empty copies of the provider objects managed for
+// app, for looking them up by key.
+func providerObjects(app *apisv1alpha1.Application, naming ProviderNaming, namespace string) []client.Object {
+	name := naming.Name(app)
+	return managedObjects(app, []client.Object{
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: serverJsonConfigMapName(name)}},
+		&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}},
+	})
+}
+
+// finalize releases the provider objects of a deleted Application according
+// to its DeletionPolicy and then removes the Application finalizer.
+func (r *ApplicationReconciler) finalize(ctx context.Context, app *apisv1alpha1.Application, namespace string) error {
+	log := log.FromContext(ctx).WithValues("providerNamespace", namespace)
+
+	if !controllerutil.ContainsFinalizer(app, FinalizerName) {
+		return nil
+	}
+	if r.ObserveOnly {
+		log.Info("Observe-only mode, leaving the Application finalizer in place")
+		return nil
+	}
+
+	if err := r.releaseOptedOut(ctx, app, namespace); err != nil {
+		return err
+	}
+
+	orphan := app.Spec.DeletionPolicy == apisv1alpha1.DeletionPolicyOrphan
+	for _, obj := range providerObjects(app, r.Naming, namespace) {
+		if err := r.ProviderClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		controllerutil.RemoveFinalizer(obj, FinalizerName)
+		if orphan {
+			labels := obj.GetLabels()
+			delete(labels, ManagedByLabel)
+			delete(labels, ApplicationLabel)
+			obj.SetLabels(labels)
+		}
+		if err := r.ProviderClient.Update(ctx, obj); err != nil {
+			return err
+		}
+
+		if orphan {
+			log.V(1).Info("Orphaned provider object", "object", client.ObjectKeyFromObject(obj))
+			continue
+		}
+		if err := r.ProviderClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		log.V(1).Info("Deleted provider object", "object", client.ObjectKeyFromObject(obj))
+	}
+
+	if err := r.unpublishDiscovery(ctx, app); err != nil {
+		return err
+	}
+
+	controllerutil.RemoveFinalizer(app, FinalizerName)
+	return r.Client.Update(ctx, app)
+}
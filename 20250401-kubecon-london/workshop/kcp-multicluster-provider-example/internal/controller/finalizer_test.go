@@ -0,0 +1,115 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When an Application is deleted", func() {
+		const resourceName = "deleted-resource"
+		const providerNamespace = "deleted"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: resourceName + "-db"}, secret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		})
+
+		provisionAndDelete := func(policy apisv1alpha1.DeletionPolicy) client.Client {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			app.Spec.DeletionPolicy = policy
+			Expect(k8sClient.Update(ctx, app)).To(Succeed())
+
+			By("provisioning the Application")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Finalizers).To(ContainElement(FinalizerName))
+
+			By("deleting the Application")
+			Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, typeNamespacedName, app)
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "the finalizer should have been removed")
+
+			return providerClient
+		}
+
+		It("should delete the provider objects with the Delete policy", func() {
+			providerClient := provisionAndDelete(apisv1alpha1.DeletionPolicyDelete)
+
+			for _, obj := range []client.Object{&appsv1.Deployment{}, &corev1.Service{}} {
+				err := providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, obj)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			}
+			err := providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName + "-servers"}, &corev1.ConfigMap{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: "pg"}, &cnpgapiv1.Cluster{})).To(Succeed())
+		})
+
+		It("should keep the provider objects with the Orphan policy", func() {
+			providerClient := provisionAndDelete(apisv1alpha1.DeletionPolicyOrphan)
+
+			deployment := &appsv1.Deployment{}
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, deployment)).To(Succeed())
+			Expect(deployment.Finalizers).NotTo(ContainElement(FinalizerName))
+			Expect(deployment.Labels).NotTo(HaveKey(ManagedByLabel))
+			Expect(deployment.Labels).NotTo(HaveKey(ApplicationLabel))
+
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, &corev1.Service{})).To(Succeed())
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName + "-servers"}, &corev1.ConfigMap{})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,42 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// generationAdvanced reports whether the spec of app was changed since it
+// was read at the start of the reconcile. Provider objects built from the
+// stale spec must not be applied then, or they could overwrite those of a
+// concurrent reconcile of the newer spec. Without a status subresource every
+// status write bumps the generation, so the specs are compared instead.
+func (r *ApplicationReconciler) generationAdvanced(ctx context.Context, app *apisv1alpha1.Application) (bool, error) {
+	var current apisv1alpha1.Application
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(app), &current); err != nil {
+		return false, err
+	}
+	if r.NoStatusSubresource {
+		return !equality.Semantic.DeepEqual(current.Spec, app.Spec), nil
+	}
+	return current.Generation > app.Generation, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When the Application changes during a reconcile", func() {
+		const resourceName = "generation-resource"
+		const providerNamespace = "generation"
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should not apply the stale provider objects", func() {
+			bumped := false
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, ok := obj.(*cnpgapiv1.Database); ok && !bumped {
+						By("bumping the spec while the provider database is resolved")
+						bumped = true
+						app := &apisv1alpha1.Application{}
+						Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+						app.Spec.DeletionPolicy = apisv1alpha1.DeletionPolicyOrphan
+						Expect(k8sClient.Update(ctx, app)).To(Succeed())
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+			})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bumped).To(BeTrue())
+			Expect(result.Requeue).To(BeTrue())
+			err = providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			By("reconciling the newer spec")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, &appsv1.Deployment{})).To(Succeed())
+		})
+	})
+})
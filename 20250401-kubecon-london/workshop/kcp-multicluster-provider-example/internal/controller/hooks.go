@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// ReconcileHook lets downstream builds customize how an Application is
+// provisioned without changing the reconciler. The CNPG Cluster is passed
+// as observed on the provider; it is not owned by the controller, so
+// changes to it are not written back.
+type ReconcileHook interface {
+	// BeforeApply is called with the desired provider objects before they
+	// are applied and may mutate them. An error aborts the reconcile.
+	BeforeApply(ctx context.Context, app *apisv1alpha1.Application, cluster *cnpgapiv1.Cluster, objs []client.Object) error
+	// AfterApply is called once the provider objects were applied.
+	AfterApply(ctx context.Context, app *apisv1alpha1.Application, cluster *cnpgapiv1.Cluster, objs []client.Object) error
+}
+
+// HashedHook is implemented by ReconcileHooks whose changes to the provider
+// objects depend on more than the Application spec. The returned input is
+// folded into the spec hash, so that a change to it re-applies the provider
+// objects of an otherwise unchanged Application. An empty input leaves the
+// hash as is.
+type HashedHook interface {
+	HashInput(app *apisv1alpha1.Application) string
+}
+
+// beforeApply runs the BeforeApply method of every hook in order.
+func (r *ApplicationReconciler) beforeApply(
+	ctx context.Context, app *apisv1alpha1.Application, cluster *cnpgapiv1.Cluster, objs []client.Object,
+) error {
+	for i, hook := range r.Hooks {
+		if err := hook.BeforeApply(ctx, app, cluster, objs); err != nil {
+			return fmt.Errorf("reconcile hook %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// afterApply runs the AfterApply method of every hook in order.
+func (r *ApplicationReconciler) afterApply(
+	ctx context.Context, app *apisv1alpha1.Application, cluster *cnpgapiv1.Cluster, objs []client.Object,
+) error {
+	for i, hook := range r.Hooks {
+		if err := hook.AfterApply(ctx, app, cluster, objs); err != nil {
+			return fmt.Errorf("reconcile hook %d: %w", i, err)
+		}
+	}
+	return nil
+}
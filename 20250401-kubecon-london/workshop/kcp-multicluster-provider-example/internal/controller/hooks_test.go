@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When reconcile hooks are registered", func() {
+		const resourceName = "hooks-resource"
+		const providerNamespace = "hooks"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should apply the objects as mutated by the hooks", func() {
+			hook := &labelHook{key: "example.com/cost-center", value: "platform"}
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+				Hooks:          []ReconcileHook{hook},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hook.cluster).To(Equal("pg"))
+			Expect(hook.applied).To(BeTrue())
+
+			deployment := &appsv1.Deployment{}
+			key := types.NamespacedName{Namespace: providerNamespace, Name: resourceName}
+			Expect(providerClient.Get(ctx, key, deployment)).To(Succeed())
+			Expect(deployment.Labels).To(HaveKeyWithValue("example.com/cost-center", "platform"))
+
+			By("aborting the reconcile when a hook fails")
+			hook.err = stderrors.New("cost center unknown")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("reconcile hook 0: cost center unknown")))
+		})
+	})
+})
+
+// labelHook is a ReconcileHook that labels the desired provider Deployment.
+type labelHook struct {
+	key, value string
+	err        error
+
+	cluster string
+	applied bool
+}
+
+func (h *labelHook) BeforeApply(
+	_ context.Context, _ *apisv1alpha1.Application, cluster *cnpgapiv1.Cluster, objs []client.Object,
+) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.cluster = cluster.Name
+	for _, obj := range objs {
+		if _, ok := obj.(*appsv1.Deployment); ok {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[h.key] = h.value
+			obj.SetLabels(labels)
+		}
+	}
+	return nil
+}
+
+func (h *labelHook) AfterApply(context.Context, *apisv1alpha1.Application, *cnpgapiv1.Cluster, []client.Object) error {
+	h.applied = true
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// getApplicationIngress returns the Ingress that routes the Host of the
+// Application's Ingress spec to its Service.
+func getApplicationIngress(app *apisv1alpha1.Application, name, namespace string) *networkingv1.Ingress {
+	spec := app.Spec.Ingress
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    managedLabels(app),
+			Finalizers: []string{
+				FinalizerName,
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: spec.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: name,
+									Port: networkingv1.ServiceBackendPort{Number: pgAdminPort},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if spec.ClassName != "" {
+		ingress.Spec.IngressClassName = &spec.ClassName
+	}
+	if spec.TLSSecretName != "" {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{spec.Host},
+			SecretName: spec.TLSSecretName,
+		}}
+	}
+	return ingress
+}
+
+// pruneIngress deletes the Ingress of an Application whose Ingress spec was
+// removed. An Ingress that is not managed for app is left alone.
+func (r *ApplicationReconciler) pruneIngress(ctx context.Context, app *apisv1alpha1.Application, namespace string) error {
+	if app.Spec.Ingress != nil {
+		return nil
+	}
+	var ingress networkingv1.Ingress
+	key := types.NamespacedName{Namespace: namespace, Name: r.Naming.Name(app)}
+	if err := r.ProviderClient.Get(ctx, key, &ingress); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if ingress.Labels[ManagedByLabel] != managedByValue || ingress.Labels[ApplicationLabel] != app.Name {
+		return nil
+	}
+
+	if controllerutil.RemoveFinalizer(&ingress, FinalizerName) {
+		if err := r.ProviderClient.Update(ctx, &ingress); err != nil {
+			return err
+		}
+	}
+	if err := r.ProviderClient.Delete(ctx, &ingress); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	log.FromContext(ctx).Info("Deleted provider Ingress", "object", key)
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When the Application requests an Ingress", func() {
+		const resourceName = "ingress-resource"
+		const providerNamespace = "ingress"
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		ingressKey := types.NamespacedName{Namespace: providerNamespace, Name: resourceName}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			if err := k8sClient.Get(ctx, typeNamespacedName, &apisv1alpha1.Application{}); err == nil {
+				deleteApplicationFixture(ctx, typeNamespacedName)
+				return
+			}
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: resourceName + "-db"}, secret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		})
+
+		setIngress := func(ingress *apisv1alpha1.ApplicationIngress) {
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			app.Spec.Ingress = ingress
+			Expect(k8sClient.Update(ctx, app)).To(Succeed())
+		}
+
+		It("should create the Ingress and tear it down", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			By("provisioning the Application with an Ingress")
+			setIngress(&apisv1alpha1.ApplicationIngress{
+				Host:          "orders.example.com",
+				ClassName:     "nginx",
+				TLSSecretName: "orders-tls",
+			})
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			ingress := &networkingv1.Ingress{}
+			Expect(providerClient.Get(ctx, ingressKey, ingress)).To(Succeed())
+			Expect(ingress.Labels).To(HaveKeyWithValue(ApplicationLabel, resourceName))
+			Expect(ingress.Finalizers).To(ContainElement(FinalizerName))
+			Expect(ingress.Spec.IngressClassName).To(HaveValue(Equal("nginx")))
+			Expect(ingress.Spec.Rules).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].Host).To(Equal("orders.example.com"))
+			backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+			Expect(backend.Name).To(Equal(resourceName))
+			Expect(backend.Port.Number).To(BeEquivalentTo(pgAdminPort))
+			Expect(ingress.Spec.TLS).To(ConsistOf(networkingv1.IngressTLS{
+				Hosts:      []string{"orders.example.com"},
+				SecretName: "orders-tls",
+			}))
+
+			By("removing the Ingress from the spec")
+			setIngress(nil)
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = providerClient.Get(ctx, ingressKey, &networkingv1.Ingress{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			By("adding back an Ingress without class and TLS")
+			setIngress(&apisv1alpha1.ApplicationIngress{Host: "orders.example.com"})
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providerClient.Get(ctx, ingressKey, ingress)).To(Succeed())
+			Expect(ingress.Spec.IngressClassName).To(BeNil())
+			Expect(ingress.Spec.TLS).To(BeEmpty())
+
+			By("deleting the Application")
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = providerClient.Get(ctx, ingressKey, &networkingv1.Ingress{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should update the Ingress when its spec changes", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			setIngress(&apisv1alpha1.ApplicationIngress{Host: "a.example.com", ClassName: "nginx"})
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("changing the host, class and TLS secret")
+			setIngress(&apisv1alpha1.ApplicationIngress{
+				Host:          "b.example.com",
+				ClassName:     "traefik",
+				TLSSecretName: "b-tls",
+			})
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			ingress := &networkingv1.Ingress{}
+			Expect(providerClient.Get(ctx, ingressKey, ingress)).To(Succeed())
+			Expect(ingress.Spec.IngressClassName).To(HaveValue(Equal("traefik")))
+			Expect(ingress.Spec.Rules).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].Host).To(Equal("b.example.com"))
+			Expect(ingress.Spec.TLS).To(ConsistOf(networkingv1.IngressTLS{
+				Hosts:      []string{"b.example.com"},
+				SecretName: "b-tls",
+			}))
+			Expect(ingress.Labels).To(HaveKeyWithValue(ApplicationLabel, resourceName))
+			Expect(ingress.Finalizers).To(ContainElement(FinalizerName))
+		})
+
+		It("should leave an Ingress it does not manage alone", func() {
+			foreign := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: providerNamespace, Name: resourceName}}
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{}, foreign)
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providerClient.Get(ctx, ingressKey, &networkingv1.Ingress{})).To(Succeed())
+		})
+	})
+})
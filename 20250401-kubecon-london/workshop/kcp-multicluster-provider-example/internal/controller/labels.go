@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// LabelPropagationHook is a ReconcileHook that copies the given labels of
+// an Application onto its provider objects, so that provider usage can be
+// attributed to the Application's owner. Labels are merged into objects that
+// already exist, and a change to them re-applies the provider objects.
+type LabelPropagationHook struct {
+	Keys []string
+}
+
+var (
+	_ ReconcileHook = LabelPropagationHook{}
+	_ HashedHook    = LabelPropagationHook{}
+)
+
+// BeforeApply implements ReconcileHook.
+func (h LabelPropagationHook) BeforeApply(
+	_ context.Context, app *apisv1alpha1.Application, _ *cnpgapiv1.Cluster, objs []client.Object,
+) error {
+	for _, obj := range objs {
+		labels := obj.GetLabels()
+		for _, key := range h.Keys {
+			value, ok := app.Labels[key]
+			if !ok {
+				continue
+			}
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[key] = value
+		}
+		obj.SetLabels(labels)
+	}
+	return nil
+}
+
+// HashInput implements HashedHook.
+func (h LabelPropagationHook) HashInput(app *apisv1alpha1.Application) string {
+	var input []string
+	for _, key := range h.Keys {
+		if value, ok := app.Labels[key]; ok {
+			input = append(input, key+"="+value)
+		}
+	}
+	return strings.Join(input, ",")
+}
+
+// AfterApply implements ReconcileHook.
+func (h LabelPropagationHook) AfterApply(context.Context, *apisv1alpha1.Application, *cnpgapiv1.Cluster, []client.Object) error {
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When propagating cost attribution labels", func() {
+		const resourceName = "labels-resource"
+		const providerNamespace = "labels"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			app.Labels = map[string]string{"team": "billing", "cost-center": "cc-1234", "tier": "gold"}
+			Expect(k8sClient.Update(ctx, app)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should copy the required labels onto the provider objects", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+				Hooks:          []ReconcileHook{LabelPropagationHook{Keys: []string{"team", "cost-center"}}},
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			for _, obj := range providerObjects(app, controllerReconciler.Naming, providerNamespace) {
+				Expect(providerClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+				Expect(obj.GetLabels()).To(HaveKeyWithValue("team", "billing"))
+				Expect(obj.GetLabels()).To(HaveKeyWithValue("cost-center", "cc-1234"))
+				Expect(obj.GetLabels()).To(HaveKeyWithValue(ManagedByLabel, managedByValue))
+				Expect(obj.GetLabels()).NotTo(HaveKey("tier"))
+			}
+		})
+
+		It("should add the labels to provider objects that already exist", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			By("provisioning the Application without label propagation")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			for _, obj := range providerObjects(app, controllerReconciler.Naming, providerNamespace) {
+				Expect(providerClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+				Expect(obj.GetLabels()).NotTo(HaveKey("team"))
+			}
+
+			By("enabling label propagation")
+			controllerReconciler.Hooks = []ReconcileHook{LabelPropagationHook{Keys: []string{"team", "cost-center"}}}
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			for _, obj := range providerObjects(app, controllerReconciler.Naming, providerNamespace) {
+				Expect(providerClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+				Expect(obj.GetLabels()).To(HaveKeyWithValue("team", "billing"))
+				Expect(obj.GetLabels()).To(HaveKeyWithValue("cost-center", "cc-1234"))
+				Expect(obj.GetLabels()).To(HaveKeyWithValue(ApplicationLabel, resourceName))
+			}
+
+			By("changing a propagated label")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			app.Labels["team"] = "payments"
+			Expect(k8sClient.Update(ctx, app)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			for _, obj := range providerObjects(app, controllerReconciler.Naming, providerNamespace) {
+				Expect(providerClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+				Expect(obj.GetLabels()).To(HaveKeyWithValue("team", "payments"))
+			}
+		})
+	})
+})
@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	// LogLevelAnnotation raises the reconcile log verbosity for a single
+	// Application to the given level.
+	LogLevelAnnotation = "contrib.kcp.io/log-level"
+)
+
+// withLogLevel returns a context whose logger emits messages up to the
+// verbosity requested via LogLevelAnnotation, regardless of the global
+// verbosity. Invalid or missing annotations leave ctx untouched.
+func withLogLevel(ctx context.Context, app *apisv1alpha1.Application) context.Context {
+	value, ok := app.Annotations[LogLevelAnnotation]
+	if !ok {
+		return ctx
+	}
+	level, err := strconv.Atoi(value)
+	if err != nil || level <= 0 {
+		return ctx
+	}
+
+	logger := log.FromContext(ctx)
+	return log.IntoContext(ctx, logr.New(&verbositySink{LogSink: logger.GetSink(), level: level}))
+}
+
+// verbositySink forwards messages up to level to the wrapped sink as if they
+// were logged at verbosity 0.
+type verbositySink struct {
+	logr.LogSink
+	level int
+}
+
+// Init is a no-op, the wrapped sink has been initialized already.
+func (s *verbositySink) Init(logr.RuntimeInfo) {}
+
+func (s *verbositySink) Enabled(level int) bool {
+	return level <= s.level || s.LogSink.Enabled(level)
+}
+
+func (s *verbositySink) Info(level int, msg string, keysAndValues ...any) {
+	if level <= s.level {
+		level = 0
+	}
+	s.LogSink.Info(level, msg, keysAndValues...)
+}
+
+func (s *verbositySink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &verbositySink{LogSink: s.LogSink.WithValues(keysAndValues...), level: s.level}
+}
+
+func (s *verbositySink) WithName(name string) logr.LogSink {
+	return &verbositySink{LogSink: s.LogSink.WithName(name), level: s.level}
+}
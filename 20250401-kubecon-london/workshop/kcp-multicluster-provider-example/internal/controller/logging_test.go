@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Per-Application log level", func() {
+	var (
+		lines []string
+		ctx   context.Context
+	)
+
+	BeforeEach(func() {
+		lines = nil
+		logger := funcr.New(func(_, args string) {
+			lines = append(lines, args)
+		}, funcr.Options{Verbosity: 0})
+		ctx = log.IntoContext(context.Background(), logger)
+	})
+
+	It("should emit high-verbosity lines for an annotated Application", func() {
+		app := &apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
+			Name:        "debugged",
+			Annotations: map[string]string{LogLevelAnnotation: "2"},
+		}}
+
+		logger := log.FromContext(withLogLevel(ctx, app)).WithValues("application", app.Name)
+		logger.V(2).Info("debug line")
+		logger.V(3).Info("too verbose")
+
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring(`"msg"="debug line"`))
+		Expect(lines[0]).To(ContainSubstring(`"application"="debugged"`))
+	})
+
+	It("should not emit high-verbosity lines for other Applications", func() {
+		app := &apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "quiet"}}
+
+		log.FromContext(withLogLevel(ctx, app)).V(2).Info("debug line")
+
+		Expect(lines).To(BeEmpty())
+	})
+})
@@ -0,0 +1,92 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+)
+
+const (
+	// overflowWorkspace is the workspace label value used once the number of
+	// distinct workspace labels reached its limit.
+	overflowWorkspace = "other"
+)
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "application_reconcile_total",
+		Help: "Total number of Application reconciles by action taken on the provider.",
+	}, []string{"action", "workspace"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "application_reconcile_duration_seconds",
+		Help:    "Duration of Application reconciles.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"workspace"})
+
+	statusWritesSkippedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "application_status_writes_skipped_total",
+		Help: "Total number of Application status updates skipped because the status did not change.",
+	})
+)
+
+func init() {
+	domainmetrics.MustRegister(reconcileTotal, reconcileDuration, statusWritesSkippedTotal)
+}
+
+// WorkspaceLabels hands out the workspace label of the Application metrics.
+// At most a fixed number of distinct workspaces are exposed, all further
+// workspaces are folded into a single overflow value. A nil WorkspaceLabels
+// disables the workspace label.
+type WorkspaceLabels struct {
+	mu         sync.Mutex
+	max        int
+	workspaces map[string]struct{}
+}
+
+// NewWorkspaceLabels returns WorkspaceLabels exposing up to max workspaces.
+func NewWorkspaceLabels(max int) *WorkspaceLabels {
+	return &WorkspaceLabels{max: max, workspaces: map[string]struct{}{}}
+}
+
+func (l *WorkspaceLabels) label(workspace string) string {
+	if l == nil {
+		return ""
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.workspaces[workspace]; ok {
+		return workspace
+	}
+	if len(l.workspaces) >= l.max {
+		return overflowWorkspace
+	}
+	l.workspaces[workspace] = struct{}{}
+	return workspace
+}
+
+func (r *ApplicationReconciler) recordMetrics(action string, duration time.Duration) {
+	workspace := r.MetricsWorkspaces.label(r.Workspace)
+	reconcileTotal.WithLabelValues(action, workspace).Inc()
+	reconcileDuration.WithLabelValues(workspace).Observe(duration.Seconds())
+}
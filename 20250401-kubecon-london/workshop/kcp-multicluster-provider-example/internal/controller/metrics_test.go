@@ -0,0 +1,50 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Reconcile metrics", func() {
+	It("should carry the workspace label when enabled", func() {
+		r := &ApplicationReconciler{Workspace: "root:metrics", MetricsWorkspaces: NewWorkspaceLabels(10)}
+		r.recordMetrics(actionCreated, time.Second)
+
+		Expect(testutil.ToFloat64(reconcileTotal.WithLabelValues(actionCreated, "root:metrics"))).To(Equal(float64(1)))
+	})
+
+	It("should leave the workspace label empty when disabled", func() {
+		before := testutil.ToFloat64(reconcileTotal.WithLabelValues(actionUpdated, ""))
+
+		r := &ApplicationReconciler{Workspace: "root:unlabelled"}
+		r.recordMetrics(actionUpdated, time.Second)
+
+		Expect(testutil.ToFloat64(reconcileTotal.WithLabelValues(actionUpdated, ""))).To(Equal(before + 1))
+	})
+
+	It("should fold workspaces beyond the limit into a single label", func() {
+		labels := NewWorkspaceLabels(1)
+		Expect(labels.label("root:first")).To(Equal("root:first"))
+		Expect(labels.label("root:second")).To(Equal(overflowWorkspace))
+		Expect(labels.label("root:first")).To(Equal("root:first"))
+	})
+})
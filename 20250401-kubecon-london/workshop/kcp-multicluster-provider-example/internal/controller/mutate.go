@@ -0,0 +1,61 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// mergeDesired merges the desired state of a provider object into the
+// existing one before it is updated. Labels, annotations and finalizers are
+// added without dropping those set by others. The spec of an Ingress is
+// replaced, as it is fully derived from the Application. Other specs are
+// left alone, since the API server defaults many of their fields.
+func mergeDesired(existing, desired client.Object) {
+	existing.SetLabels(mergeStrings(existing.GetLabels(), desired.GetLabels()))
+	existing.SetAnnotations(mergeStrings(existing.GetAnnotations(), desired.GetAnnotations()))
+	for _, finalizer := range desired.GetFinalizers() {
+		controllerutil.AddFinalizer(existing, finalizer)
+	}
+
+	if ingress, ok := existing.(*networkingv1.Ingress); ok {
+		want := desired.(*networkingv1.Ingress)
+		ingress.Spec.Rules = want.Spec.Rules
+		ingress.Spec.TLS = want.Spec.TLS
+		// An unset class is left to the IngressClass admission, which sets
+		// the cluster default.
+		if want.Spec.IngressClassName != nil {
+			ingress.Spec.IngressClassName = want.Spec.IngressClassName
+		}
+	}
+}
+
+// mergeStrings returns existing with the entries of desired added.
+func mergeStrings(existing, desired map[string]string) map[string]string {
+	if len(desired) == 0 {
+		return existing
+	}
+	if existing == nil {
+		existing = make(map[string]string, len(desired))
+	}
+	maps.Copy(existing, desired)
+	return existing
+}
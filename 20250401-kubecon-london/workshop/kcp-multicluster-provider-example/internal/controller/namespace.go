@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	// namespaceRequeueInterval is how often a provider namespace that is
+	// missing or still being deleted is checked again.
+	namespaceRequeueInterval = 10 * time.Second
+
+	// namespaceTerminatingTimeout is how long a provider namespace may stay
+	// Terminating before the Application is marked as failed.
+	namespaceTerminatingTimeout = 5 * time.Minute
+)
+
+// providerNamespace returns the provider namespace of app: the default
+// provider namespace if set, else the kcp.io/cluster annotation of the
+// workspace, else in single-cluster mode the namespace of app itself.
+func (r *ApplicationReconciler) providerNamespace(app *apisv1alpha1.Application) (string, bool) {
+	if r.DefaultProviderNamespace != "" {
+		return r.DefaultProviderNamespace, true
+	}
+	if namespace, ok := app.Annotations["kcp.io/cluster"]; ok {
+		return namespace, true
+	}
+	if r.SingleCluster {
+		return app.Namespace, true
+	}
+	return "", false
+}
+
+// providerNamespaceState reports whether the provider namespace exists and,
+// if it is Terminating, when its deletion started. terminatingSince is the
+// zero time for a namespace that is not Terminating.
+func (r *ApplicationReconciler) providerNamespaceState(ctx context.Context, namespace string) (exists bool, terminatingSince time.Time, err error) {
+	var ns corev1.Namespace
+	err = r.ProviderClient.Get(ctx, types.NamespacedName{Name: namespace}, &ns)
+	if apierrors.IsNotFound(err) {
+		return false, time.Time{}, nil
+	}
+	if err != nil {
+		return false, time.Time{}, err
+	}
+
+	if ns.Status.Phase != corev1.NamespaceTerminating && ns.DeletionTimestamp == nil {
+		return true, time.Time{}, nil
+	}
+	if ns.DeletionTimestamp == nil {
+		return true, time.Now(), nil
+	}
+	return true, ns.DeletionTimestamp.Time, nil
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When the provider namespace is Terminating", func() {
+		const resourceName = "terminating-resource"
+		const providerNamespace = "terminating"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		terminatingNamespace := func(since time.Time) *corev1.Namespace {
+			return &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              providerNamespace,
+					DeletionTimestamp: &metav1.Time{Time: since},
+					Finalizers:        []string{"kubernetes"},
+				},
+				Status: corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+			}
+		}
+
+		It("should wait for the namespace to be deleted", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{}, terminatingNamespace(time.Now()))
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(namespaceRequeueInterval))
+
+			err = providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("NamespaceTerminating"))
+		})
+
+		It("should fail once the namespace is stuck Terminating", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{},
+				terminatingNamespace(time.Now().Add(-2*namespaceTerminatingTimeout)))
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Failed"))
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("NamespaceStuckTerminating"))
+		})
+	})
+
+	Context("When the provider namespace was deleted", func() {
+		const resourceName = "missing-ns-resource"
+		const providerNamespace = "missing-ns"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should warn while it is missing and reprovision once it is back", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			recorder := record.NewFakeRecorder(10)
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+				Recorder:       recorder,
+			}
+
+			By("provisioning the Application")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("deleting the provider namespace and its contents")
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: providerNamespace}}
+			Expect(providerClient.Delete(ctx, ns)).To(Succeed())
+			for _, obj := range providerObjects(&apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: resourceName}}, ProviderNaming{}, providerNamespace) {
+				Expect(providerClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+				obj.SetFinalizers(nil)
+				Expect(providerClient.Update(ctx, obj)).To(Succeed())
+				Expect(providerClient.Delete(ctx, obj)).To(Succeed())
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(namespaceRequeueInterval))
+			Expect(recorder.Events).To(Receive(ContainSubstring("ProviderNamespaceMissing")))
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("ProviderNamespaceMissing"))
+
+			By("recreating the provider namespace")
+			Expect(providerClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: providerNamespace}})).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, &appsv1.Deployment{})).To(Succeed())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Ready"))
+		})
+	})
+
+	Context("When running against a single cluster without kcp", func() {
+		const resourceName = "single-cluster-resource"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, "")
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			delete(app.Annotations, "kcp.io/cluster")
+			Expect(k8sClient.Update(ctx, app)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should provision into the Application's own namespace", func() {
+			providerClient := newProviderClient(typeNamespacedName.Namespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			By("requiring the kcp annotation outside single-cluster mode")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).To(MatchError(ContainSubstring("cluster label not found")))
+
+			By("reconciling in single-cluster mode")
+			controllerReconciler.SingleCluster = true
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(providerClient.Get(ctx, typeNamespacedName, &appsv1.Deployment{})).To(Succeed())
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Ready"))
+		})
+	})
+})
@@ -0,0 +1,65 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// maxProviderNameLength is the longest name a provider object may get. It is
+// the DNS label limit, which applies to the Service.
+const maxProviderNameLength = 63
+
+// ProviderNaming derives the names of the provider objects of an
+// Application. The zero value uses the Application name.
+type ProviderNaming struct {
+	// Prefix is prepended to the Application name.
+	Prefix string
+	// Suffix is appended to the Application name.
+	Suffix string
+	// Unique adds a hash of the workspace and namespace of the Application,
+	// so that Applications of the same name from different workspaces can
+	// share a provider namespace.
+	Unique bool
+}
+
+// Name returns the name of the provider Deployment and Service of app. Names
+// exceeding the DNS label limit are truncated and made unique again with a
+// hash of the full name.
+func (n ProviderNaming) Name(app *apisv1alpha1.Application) string {
+	base := app.Name
+	if n.Unique {
+		base += "-" + shortHash(app.Annotations["kcp.io/cluster"]+"/"+app.Namespace+"/"+app.Name)
+	}
+	name := n.Prefix + base + n.Suffix
+	if len(name) <= maxProviderNameLength {
+		return name
+	}
+
+	hash := shortHash(name)
+	truncated := strings.TrimRight(name[:maxProviderNameLength-len(hash)-1], "-.")
+	return truncated + "-" + hash
+}
+
+func shortHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:4])
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Provider naming", func() {
+	app := func(name string) *apisv1alpha1.Application {
+		return &apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+
+	It("should use the Application name by default", func() {
+		Expect(ProviderNaming{}.Name(app("pgadmin"))).To(Equal("pgadmin"))
+	})
+
+	It("should apply the prefix and suffix", func() {
+		naming := ProviderNaming{Prefix: "db-", Suffix: "-prod"}
+		Expect(naming.Name(app("orders"))).To(Equal("db-orders-prod"))
+	})
+
+	It("should truncate long names and keep them unique", func() {
+		naming := ProviderNaming{Prefix: "db-", Suffix: "-prod"}
+		first := naming.Name(app(strings.Repeat("a", 60) + "-first"))
+		second := naming.Name(app(strings.Repeat("a", 60) + "-second"))
+
+		Expect(len(first)).To(BeNumerically("<=", maxProviderNameLength))
+		Expect(len(second)).To(BeNumerically("<=", maxProviderNameLength))
+		Expect(first).To(HavePrefix("db-aaaa"))
+		Expect(first).NotTo(Equal(second))
+		Expect(naming.Name(app(strings.Repeat("a", 60)+"-first"))).To(Equal(first), "names must be stable")
+	})
+
+	It("should make names unique per workspace and namespace", func() {
+		naming := ProviderNaming{Unique: true}
+		first := app("pgadmin")
+		first.Namespace = "default"
+		first.Annotations = map[string]string{"kcp.io/cluster": "root:first"}
+		second := first.DeepCopy()
+		second.Annotations["kcp.io/cluster"] = "root:second"
+
+		Expect(naming.Name(first)).To(HavePrefix("pgadmin-"))
+		Expect(naming.Name(first)).NotTo(Equal(naming.Name(second)))
+	})
+
+	It("should use the same names for provisioning and lookups", func() {
+		naming := ProviderNaming{Prefix: "db-"}
+		db := &cnpgapiv1.Database{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "provider"},
+			Spec:       cnpgapiv1.DatabaseSpec{ClusterRef: corev1.LocalObjectReference{Name: "pg"}},
+		}
+		cluster := &cnpgapiv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "provider"}}
+		objs, err := BuildProviderObjects(app("orders"), naming, "provider", db, cluster, corev1.Secret{})
+		Expect(err).NotTo(HaveOccurred())
+
+		lookups := providerObjects(app("orders"), naming, "provider")
+		Expect(objs).To(HaveLen(len(lookups)))
+		for i := range objs {
+			Expect(objs[i].GetName()).To(Equal(lookups[i].GetName()))
+			Expect(objs[i].GetNamespace()).To(Equal(lookups[i].GetNamespace()))
+		}
+	})
+})
+
+var _ = Describe("Application Controller", func() {
+	Context("When all Applications share a default provider namespace", func() {
+		const resourceName = "shared-resource"
+		const providerNamespace = "shared"
+
+		ctx := context.Background()
+
+		first := types.NamespacedName{Name: resourceName, Namespace: "default"}
+		second := types.NamespacedName{Name: resourceName, Namespace: "shared-other"}
+
+		BeforeEach(func() {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: second.Namespace}}
+			if err := k8sClient.Create(ctx, ns); err != nil {
+				Expect(errors.IsAlreadyExists(err)).To(BeTrue())
+			}
+			createApplicationFixture(ctx, first, "root:first")
+			createApplicationFixture(ctx, second, "root:second")
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, first)
+			deleteApplicationFixture(ctx, second)
+		})
+
+		It("should create the provider objects in that namespace with unique names", func() {
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:                   k8sClient,
+				Scheme:                   k8sClient.Scheme(),
+				ProviderClient:           providerClient,
+				DefaultProviderNamespace: providerNamespace,
+				Naming:                   ProviderNaming{Unique: true},
+			}
+
+			for _, key := range []types.NamespacedName{first, second} {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			var deployments appsv1.DeploymentList
+			Expect(providerClient.List(ctx, &deployments, client.InNamespace(providerNamespace))).To(Succeed())
+			Expect(deployments.Items).To(HaveLen(2))
+			Expect(deployments.Items[0].Name).NotTo(Equal(deployments.Items[1].Name))
+			for _, deployment := range deployments.Items {
+				Expect(deployment.Name).To(HavePrefix(resourceName + "-"))
+			}
+		})
+	})
+})
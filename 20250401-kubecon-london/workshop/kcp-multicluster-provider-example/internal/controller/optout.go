@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	// ManageServiceAnnotation set to "false" leaves the provider Service of
+	// an Application to be managed by hand.
+	ManageServiceAnnotation = "contrib.kcp.io/manage-service"
+	// ManageServerConfigAnnotation set to "false" leaves the pgAdmin server
+	// ConfigMap of an Application to be managed by hand.
+	ManageServerConfigAnnotation = "contrib.kcp.io/manage-server-config"
+)
+
+// isManaged reports whether the controller manages obj for app. Opted-out
+// objects are neither created, updated nor deleted, and are released by
+// releaseOptedOut if the controller created them before.
+func isManaged(app *apisv1alpha1.Application, obj client.Object) bool {
+	switch obj.(type) {
+	case *corev1.Service:
+		return app.Annotations[ManageServiceAnnotation] != "false"
+	case *corev1.ConfigMap:
+		return app.Annotations[ManageServerConfigAnnotation] != "false"
+	case *networkingv1.Ingress:
+		return app.Spec.Ingress != nil
+	}
+	return true
+}
+
+// managedObjects returns the objs managed for app.
+func managedObjects(app *apisv1alpha1.Application, objs []client.Object) []client.Object {
+	managed := make([]client.Object, 0, len(objs))
+	for _, obj := range objs {
+		if isManaged(app, obj) {
+			managed = append(managed, obj)
+		}
+	}
+	return managed
+}
+
+// releaseOptedOut hands the existing provider objects app opted out of over
+// to be managed by hand. Objects created by the controller lose its
+// finalizer and management labels, so that they are no longer deleted with
+// the Application. Objects the controller never managed are left alone.
+func (r *ApplicationReconciler) releaseOptedOut(ctx context.Context, app *apisv1alpha1.Application, namespace string) error {
+	name := r.Naming.Name(app)
+	for _, obj := range []client.Object{
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: serverJsonConfigMapName(name)}},
+	} {
+		if isManaged(app, obj) {
+			continue
+		}
+		if err := r.ProviderClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if obj.GetLabels()[ApplicationLabel] != app.Name {
+			continue
+		}
+
+		labels := obj.GetLabels()
+		delete(labels, ManagedByLabel)
+		delete(labels, ApplicationLabel)
+		obj.SetLabels(labels)
+		controllerutil.RemoveFinalizer(obj, FinalizerName)
+		if err := r.ProviderClient.Update(ctx, obj); err != nil {
+			return err
+		}
+		log.FromContext(ctx).Info("Released opted-out provider object", "object", client.ObjectKeyFromObject(obj))
+	}
+	return nil
+}
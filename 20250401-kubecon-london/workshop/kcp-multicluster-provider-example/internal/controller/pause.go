@@ -0,0 +1,44 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	// PausedAnnotation pauses all writes to the provider for an Application
+	// when set to "true".
+	PausedAnnotation = "contrib.kcp.io/paused"
+
+	// PauseModeAnnotation selects how a paused Application is reconciled.
+	// With PauseModeObserve the provider is still read and drift is reported
+	// on status as in observe-only mode, without applying it.
+	PauseModeAnnotation = "contrib.kcp.io/pause-mode"
+
+	// PauseModeObserve reports drift on a paused Application.
+	PauseModeObserve = "observe"
+)
+
+// pauseState returns whether app is paused and whether drift should still be
+// observed while it is.
+func pauseState(app *apisv1alpha1.Application) (paused, observe bool) {
+	if app.Annotations[PausedAnnotation] != "true" {
+		return false, false
+	}
+	return true, app.Annotations[PauseModeAnnotation] == PauseModeObserve
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// PreCheck reports why app clearly cannot be provisioned on the provider,
+// or an empty reason if provisioning may succeed. It only covers the cheap
+// checks: the provider namespace must exist and not be Terminating, and
+// the provider objects must fit into its quota. Applications the check
+// cannot resolve, for example without a provider namespace annotation, are
+// left to the reconciler.
+func (r *ApplicationReconciler) PreCheck(ctx context.Context, app *apisv1alpha1.Application) (string, error) {
+	namespace, ok := r.providerNamespace(app)
+	if !ok {
+		return "", nil
+	}
+
+	exists, terminatingSince, err := r.providerNamespaceState(ctx, namespace)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return fmt.Sprintf("provider namespace %s does not exist", namespace), nil
+	}
+	if !terminatingSince.IsZero() {
+		return fmt.Sprintf("provider namespace %s is being deleted", namespace), nil
+	}
+
+	exceeded, err := r.checkQuota(ctx, namespace, providerObjects(app, r.Naming, namespace)...)
+	if err != nil {
+		return "", err
+	}
+	if exceeded != "" {
+		return "provider quota exceeded: " + exceeded, nil
+	}
+	return "", nil
+}
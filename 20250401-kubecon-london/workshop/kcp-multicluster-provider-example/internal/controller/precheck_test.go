@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When pre-checking an Application at admission", func() {
+		const providerNamespace = "precheck"
+
+		ctx := context.Background()
+
+		app := func(namespace string) *apisv1alpha1.Application {
+			return &apisv1alpha1.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "precheck-resource",
+					Namespace:   "default",
+					Annotations: map[string]string{"kcp.io/cluster": namespace},
+				},
+			}
+		}
+
+		It("should rule out a missing provider namespace", func() {
+			checker := &ApplicationReconciler{ProviderClient: newProviderClient(providerNamespace, interceptor.Funcs{})}
+
+			reason, err := checker.PreCheck(ctx, app("missing"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reason).To(ContainSubstring("provider namespace missing does not exist"))
+
+			reason, err = checker.PreCheck(ctx, app(providerNamespace))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("should rule out an exhausted provider quota", func() {
+			quota := &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tight",
+					Namespace: providerNamespace,
+				},
+				Status: corev1.ResourceQuotaStatus{
+					Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+					Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+				},
+			}
+			checker := &ApplicationReconciler{ProviderClient: newProviderClient(providerNamespace, interceptor.Funcs{}, quota)}
+
+			reason, err := checker.PreCheck(ctx, app(providerNamespace))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reason).To(ContainSubstring("provider quota exceeded"))
+		})
+	})
+})
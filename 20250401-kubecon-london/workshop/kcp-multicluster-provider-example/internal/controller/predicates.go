@@ -0,0 +1,107 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// ApplicationPredicate filters Application events down to the ones the
+// reconciler needs to act on. Updates that only touch status or
+// server-managed metadata are dropped, as every status write would otherwise
+// re-enqueue the Application. Annotation and label changes still pass so
+// that annotation-driven behaviour and label propagation keep working.
+func ApplicationPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.AnnotationChangedPredicate{},
+		predicate.LabelChangedPredicate{},
+	)
+}
+
+// ApplicationPredicateWithoutStatusSubresource is ApplicationPredicate for
+// Application CRDs without a status subresource. Every status write bumps the
+// generation then, so spec changes are detected by comparing the specs
+// instead.
+func ApplicationPredicateWithoutStatusSubresource() predicate.Predicate {
+	return predicate.Or(
+		predicate.Funcs{UpdateFunc: func(e event.UpdateEvent) bool {
+			oldApp, ok := e.ObjectOld.(*apisv1alpha1.Application)
+			if !ok {
+				return true
+			}
+			newApp, ok := e.ObjectNew.(*apisv1alpha1.Application)
+			if !ok {
+				return true
+			}
+			return !equality.Semantic.DeepEqual(oldApp.Spec, newApp.Spec) ||
+				oldApp.DeletionTimestamp.IsZero() != newApp.DeletionTimestamp.IsZero()
+		}},
+		predicate.AnnotationChangedPredicate{},
+		predicate.LabelChangedPredicate{},
+	)
+}
+
+// AnnotationRequirement is an annotation Applications must bear to be
+// provisioned, either any value of Key or, if Exact, exactly Value.
+type AnnotationRequirement struct {
+	Key   string
+	Value string
+	Exact bool
+}
+
+// ParseAnnotationRequirement parses requirement, either "key" for any value
+// or "key=value" for an exact value.
+func ParseAnnotationRequirement(requirement string) (*AnnotationRequirement, error) {
+	key, value, exact := strings.Cut(requirement, "=")
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, ", "))
+	}
+	return &AnnotationRequirement{Key: key, Value: value, Exact: exact}, nil
+}
+
+// Matches reports whether obj bears the required annotation. A nil
+// requirement matches any object.
+func (a *AnnotationRequirement) Matches(obj client.Object) bool {
+	if a == nil {
+		return true
+	}
+	got, ok := obj.GetAnnotations()[a.Key]
+	return ok && (!a.Exact || got == a.Value)
+}
+
+// RequiredAnnotationPredicate only passes events for Applications matching
+// requirement. It lets a controller act on opted-in Applications only, for
+// example during a staged rollout. Applications that are being deleted
+// always pass, so that an Application provisioned before its annotation was
+// removed, or before the requirement was configured, can still be finalized.
+// Events from other sources bypass the predicate, so the reconciler has to
+// check the requirement as well, see ApplicationReconciler.RequiredAnnotation.
+func RequiredAnnotationPredicate(requirement *AnnotationRequirement) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetDeletionTimestamp() != nil || requirement.Matches(obj)
+	})
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application predicate", func() {
+	var old *apisv1alpha1.Application
+
+	BeforeEach(func() {
+		old = &apisv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "app",
+				Namespace:       "default",
+				Generation:      1,
+				ResourceVersion: "1",
+				Annotations:     map[string]string{"kcp.io/cluster": "root"},
+			},
+			Spec: apisv1alpha1.ApplicationSpec{DatabaseRef: "db"},
+		}
+	})
+
+	It("should ignore status-only updates", func() {
+		updated := old.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Status.Status = "Ready"
+
+		Expect(ApplicationPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})).To(BeFalse())
+	})
+
+	It("should pass spec changes", func() {
+		updated := old.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Generation = 2
+		updated.Spec.DatabaseRef = "other"
+
+		Expect(ApplicationPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})).To(BeTrue())
+	})
+
+	It("should pass annotation changes", func() {
+		updated := old.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Annotations["contrib.kcp.io/paused"] = "true"
+
+		Expect(ApplicationPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})).To(BeTrue())
+	})
+
+	It("should pass label changes", func() {
+		updated := old.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Labels = map[string]string{"team": "billing"}
+
+		Expect(ApplicationPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})).To(BeTrue())
+	})
+})
+
+var _ = Describe("Required annotation predicate", func() {
+	app := func(annotations map[string]string) *apisv1alpha1.Application {
+		return &apisv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Annotations: annotations},
+		}
+	}
+
+	It("should only pass Applications with the exact annotation value", func() {
+		requirement, err := ParseAnnotationRequirement("contrib.kcp.io/enabled=true")
+		Expect(err).NotTo(HaveOccurred())
+		p := RequiredAnnotationPredicate(requirement)
+
+		Expect(p.Create(event.CreateEvent{Object: app(map[string]string{"contrib.kcp.io/enabled": "true"})})).To(BeTrue())
+		Expect(p.Create(event.CreateEvent{Object: app(map[string]string{"contrib.kcp.io/enabled": "false"})})).To(BeFalse())
+		Expect(p.Create(event.CreateEvent{Object: app(nil)})).To(BeFalse())
+	})
+
+	It("should pass Applications that opt in later", func() {
+		requirement, err := ParseAnnotationRequirement("contrib.kcp.io/enabled=true")
+		Expect(err).NotTo(HaveOccurred())
+		p := RequiredAnnotationPredicate(requirement)
+
+		old := app(map[string]string{})
+		updated := app(map[string]string{"contrib.kcp.io/enabled": "true"})
+		Expect(predicate.And(ApplicationPredicate(), p).Update(event.UpdateEvent{ObjectOld: old, ObjectNew: updated})).To(BeTrue())
+	})
+
+	It("should pass any value when only a key is required", func() {
+		requirement, err := ParseAnnotationRequirement("contrib.kcp.io/enabled")
+		Expect(err).NotTo(HaveOccurred())
+		p := RequiredAnnotationPredicate(requirement)
+
+		Expect(p.Create(event.CreateEvent{Object: app(map[string]string{"contrib.kcp.io/enabled": ""})})).To(BeTrue())
+		Expect(p.Create(event.CreateEvent{Object: app(nil)})).To(BeFalse())
+	})
+
+	It("should only pass Applications without the annotation once they are deleted", func() {
+		requirement, err := ParseAnnotationRequirement("contrib.kcp.io/enabled=true")
+		Expect(err).NotTo(HaveOccurred())
+		p := RequiredAnnotationPredicate(requirement)
+
+		old := app(map[string]string{})
+		old.Finalizers = []string{FinalizerName}
+		Expect(p.Create(event.CreateEvent{Object: old})).To(BeFalse())
+
+		deleted := old.DeepCopy()
+		deleted.Generation = 2
+		now := metav1.Now()
+		deleted.DeletionTimestamp = &now
+		Expect(predicate.And(ApplicationPredicate(), p).Update(event.UpdateEvent{ObjectOld: old, ObjectNew: deleted})).To(BeTrue())
+		Expect(p.Delete(event.DeleteEvent{Object: deleted})).To(BeTrue())
+	})
+
+	It("should reject an invalid annotation key", func() {
+		_, err := ParseAnnotationRequirement("not a key=true")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Application Controller", func() {
+	Context("When Applications must bear a required annotation", func() {
+		const resourceName = "not-opted-in-resource"
+		const providerNamespace = "not-opted-in"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: resourceName + "-db"}, secret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		})
+
+		It("should not provision an Application without the annotation but still finalize it", func() {
+			requirement, err := ParseAnnotationRequirement("contrib.kcp.io/enabled=true")
+			Expect(err).NotTo(HaveOccurred())
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{})
+			controllerReconciler := &ApplicationReconciler{
+				Client:             k8sClient,
+				Scheme:             k8sClient.Scheme(),
+				ProviderClient:     providerClient,
+				RequiredAnnotation: requirement,
+			}
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			app.Finalizers = []string{FinalizerName}
+			Expect(k8sClient.Update(ctx, app)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "the Application should not have been provisioned")
+
+			By("finalizing it once it is deleted")
+			Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, typeNamespacedName, app)
+			Expect(errors.IsNotFound(err)).To(BeTrue(), "the finalizer should have been removed")
+		})
+	})
+})
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// quotasUnavailable logs only once that the provider quotas cannot be
+// listed.
+var quotasUnavailable sync.Once
+
+// quotaRequests returns the quota resources consumed by creating obj.
+func quotaRequests(obj client.Object) corev1.ResourceList {
+	one := resource.MustParse("1")
+	switch obj.(type) {
+	case *appsv1.Deployment:
+		return corev1.ResourceList{
+			"count/deployments.apps": one,
+			corev1.ResourcePods:      one,
+			"count/pods":             one,
+		}
+	case *corev1.Service:
+		return corev1.ResourceList{
+			corev1.ResourceServices: one,
+			"count/services":        one,
+		}
+	case *corev1.ConfigMap:
+		return corev1.ResourceList{
+			corev1.ResourceConfigMaps: one,
+			"count/configmaps":        one,
+		}
+	case *networkingv1.Ingress:
+		return corev1.ResourceList{
+			"count/ingresses.networking.k8s.io": one,
+		}
+	}
+	return nil
+}
+
+// checkQuota verifies that creating the missing objs in namespace fits into
+// the ResourceQuotas of that namespace. It returns a description of every
+// exceeded quota, or an empty string if provisioning can proceed. Objects
+// that already exist are accounted for in the quota usage and are skipped.
+func (r *ApplicationReconciler) checkQuota(ctx context.Context, namespace string, objs ...client.Object) (string, error) {
+	var quotas corev1.ResourceQuotaList
+	if err := r.ProviderClient.List(ctx, &quotas, client.InNamespace(namespace)); err != nil {
+		if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
+			// Provider credentials predating the quota check may not be
+			// allowed to list quotas, which must not stop provisioning.
+			quotasUnavailable.Do(func() {
+				log.FromContext(ctx).Info("Cannot list provider resource quotas, skipping the quota check", "error", err.Error())
+			})
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+	if len(quotas.Items) == 0 {
+		return "", nil
+	}
+
+	requests := corev1.ResourceList{}
+	for _, obj := range objs {
+		existing := obj.DeepCopyObject().(client.Object)
+		err := r.ProviderClient.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		for name, quantity := range quotaRequests(obj) {
+			total := requests[name]
+			total.Add(quantity)
+			requests[name] = total
+		}
+	}
+
+	var exceeded []string
+	for _, quota := range quotas.Items {
+		hard := quota.Status.Hard
+		if hard == nil {
+			hard = quota.Spec.Hard
+		}
+		for name, requested := range requests {
+			limit, ok := hard[name]
+			if !ok {
+				continue
+			}
+			used := quota.Status.Used[name]
+			used.Add(requested)
+			if used.Cmp(limit) > 0 {
+				exceeded = append(exceeded, fmt.Sprintf("%s: %s (requested %s, used %s, limited %s)",
+					quota.Name, name, requested.String(), quota.Status.Used.Name(name, resource.DecimalSI).String(), limit.String()))
+			}
+		}
+	}
+	sort.Strings(exceeded)
+
+	return strings.Join(exceeded, "; "), nil
+}
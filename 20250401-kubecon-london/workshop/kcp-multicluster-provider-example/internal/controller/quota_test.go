@@ -0,0 +1,141 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Provider quota check", func() {
+	const resourceName = "quota-forbidden-resource"
+	const providerNamespace = "quota-forbidden"
+
+	ctx := context.Background()
+
+	typeNamespacedName := types.NamespacedName{
+		Name:      resourceName,
+		Namespace: "default",
+	}
+
+	BeforeEach(func() {
+		createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+	})
+
+	AfterEach(func() {
+		deleteApplicationFixture(ctx, typeNamespacedName)
+	})
+
+	It("should provision when the provider credentials cannot list quotas", func() {
+		providerClient := newProviderClient(providerNamespace, interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*corev1.ResourceQuotaList); ok {
+					return errors.NewForbidden(schema.GroupResource{Resource: "resourcequotas"}, "", stderrors.New("denied"))
+				}
+				return c.List(ctx, list, opts...)
+			},
+		})
+		controllerReconciler := &ApplicationReconciler{
+			Client:         k8sClient,
+			Scheme:         k8sClient.Scheme(),
+			ProviderClient: providerClient,
+		}
+
+		_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+		Expect(err).NotTo(HaveOccurred())
+		deployment := &appsv1.Deployment{}
+		Expect(providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, deployment)).To(Succeed())
+	})
+})
+
+var _ = Describe("Application Controller", func() {
+	Context("When the provider namespace quota is exhausted", func() {
+		const resourceName = "quota-resource"
+		const providerNamespace = "quota"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		It("should refuse to provision and report the exceeded quota", func() {
+			quota := &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tight",
+					Namespace: providerNamespace,
+				},
+				Spec: corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+				},
+				Status: corev1.ResourceQuotaStatus{
+					Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+					Used: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")},
+				},
+			}
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{}, quota)
+
+			controllerReconciler := &ApplicationReconciler{
+				Client:         k8sClient,
+				Scheme:         k8sClient.Scheme(),
+				ProviderClient: providerClient,
+			}
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(quotaRequeueInterval))
+
+			err = providerClient.Get(ctx, types.NamespacedName{Namespace: providerNamespace, Name: resourceName}, &appsv1.Deployment{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Failed"))
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("ProviderQuotaExceeded"))
+			Expect(cond.Message).To(ContainSubstring("tight: pods"))
+		})
+	})
+})
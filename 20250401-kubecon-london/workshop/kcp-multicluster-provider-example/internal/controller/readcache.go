@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+type readCacheKey struct {
+	gvk schema.GroupVersionKind
+	key client.ObjectKey
+}
+
+// readCacheEntry is either a copy of the object read or the NotFound error
+// returned for it.
+type readCacheEntry struct {
+	obj runtime.Object
+	err error
+}
+
+// readCache is a client.Client that serves repeated GETs of the same provider
+// object from memory. It lives for a single reconcile, so that the many
+// checks reading the same objects cost one request each. Any write drops all
+// cached reads.
+type readCache struct {
+	client.Client
+
+	entries map[readCacheKey]readCacheEntry
+}
+
+func newReadCache(c client.Client) *readCache {
+	return &readCache{Client: c, entries: map[readCacheKey]readCacheEntry{}}
+}
+
+func (c *readCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if len(opts) > 0 || err != nil {
+		return c.Client.Get(ctx, key, obj, opts...)
+	}
+	cacheKey := readCacheKey{gvk: gvk, key: key}
+
+	if entry, ok := c.entries[cacheKey]; ok {
+		if entry.err != nil {
+			return entry.err
+		}
+		reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(entry.obj.DeepCopyObject()).Elem())
+		return nil
+	}
+
+	err = c.Client.Get(ctx, key, obj)
+	switch {
+	case err == nil:
+		c.entries[cacheKey] = readCacheEntry{obj: obj.DeepCopyObject()}
+	case apierrors.IsNotFound(err):
+		c.entries[cacheKey] = readCacheEntry{err: err}
+	}
+	return err
+}
+
+func (c *readCache) invalidate() {
+	clear(c.entries)
+}
+
+func (c *readCache) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.invalidate()
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *readCache) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	c.invalidate()
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *readCache) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	c.invalidate()
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *readCache) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	c.invalidate()
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+func (c *readCache) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	c.invalidate()
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *readCache) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *readCache) SubResource(subResource string) client.SubResourceClient {
+	c.invalidate()
+	return c.Client.SubResource(subResource)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Reconcile read cache", func() {
+	const providerNamespace = "read-cache"
+
+	ctx := context.Background()
+
+	var gets map[string]int
+	countGets := interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			gets[key.String()]++
+			return c.Get(ctx, key, obj, opts...)
+		},
+	}
+
+	BeforeEach(func() {
+		gets = map[string]int{}
+	})
+
+	It("should serve repeated reads from memory until a write", func() {
+		cache := newReadCache(newProviderClient(providerNamespace, countGets))
+		key := types.NamespacedName{Name: providerNamespace}
+
+		for range 3 {
+			Expect(cache.Get(ctx, key, &corev1.Namespace{})).To(Succeed())
+		}
+		Expect(gets[key.String()]).To(Equal(1))
+
+		By("returning independent copies")
+		ns := &corev1.Namespace{}
+		Expect(cache.Get(ctx, key, ns)).To(Succeed())
+		ns.Labels = map[string]string{"mutated": "true"}
+		Expect(cache.Get(ctx, key, ns)).To(Succeed())
+		Expect(ns.Labels).To(BeEmpty())
+
+		By("dropping cached reads on a write")
+		ns.Labels = map[string]string{"team": "billing"}
+		Expect(cache.Update(ctx, ns)).To(Succeed())
+		Expect(cache.Get(ctx, key, &corev1.Namespace{})).To(Succeed())
+		Expect(gets[key.String()]).To(Equal(2))
+	})
+
+	It("should cache objects that do not exist", func() {
+		cache := newReadCache(newProviderClient(providerNamespace, countGets))
+		key := types.NamespacedName{Namespace: providerNamespace, Name: "missing"}
+
+		for range 2 {
+			Expect(errors.IsNotFound(cache.Get(ctx, key, &appsv1.Deployment{}))).To(BeTrue())
+		}
+		Expect(gets[key.String()]).To(Equal(1))
+
+		By("finding the object once it was created")
+		Expect(cache.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}})).To(Succeed())
+		Expect(cache.Get(ctx, key, &appsv1.Deployment{})).To(Succeed())
+	})
+
+	It("should tell objects of different kinds apart", func() {
+		cache := newReadCache(newProviderClient(providerNamespace, countGets))
+		key := types.NamespacedName{Namespace: providerNamespace, Name: "pg"}
+
+		Expect(errors.IsNotFound(cache.Get(ctx, key, &corev1.Service{}))).To(BeTrue())
+		Expect(errors.IsNotFound(cache.Get(ctx, key, &corev1.ConfigMap{}))).To(BeTrue())
+		Expect(gets[key.String()]).To(Equal(2))
+	})
+
+	It("should read every provider object at most once per reconcile", func() {
+		typeNamespacedName := types.NamespacedName{Name: "read-cache-resource", Namespace: "default"}
+		createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		defer deleteApplicationFixture(ctx, typeNamespacedName)
+
+		controllerReconciler := &ApplicationReconciler{
+			Client:         k8sClient,
+			Scheme:         k8sClient.Scheme(),
+			ProviderClient: newProviderClient(providerNamespace, countGets),
+		}
+		_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+		Expect(err).NotTo(HaveOccurred())
+
+		app := &apisv1alpha1.Application{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+		Expect(app.Status.Status).To(Equal("Ready"))
+
+		By("reconciling again with nothing to create")
+		gets = map[string]int{}
+		_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gets).NotTo(BeEmpty())
+		for key, n := range gets {
+			Expect(n).To(Equal(1), "GETs of %s", key)
+		}
+	})
+})
@@ -0,0 +1,57 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// providerRejection reports whether err is a terminal admission rejection by
+// the provider, such as a failed validation or a denying admission webhook or
+// policy, and describes it including the offending field paths. Retrying
+// does not help with these until the Application changes.
+func providerRejection(err error) (string, bool) {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return "", false
+	}
+	switch {
+	case apierrors.IsInvalid(err):
+	case apierrors.IsForbidden(err) && strings.Contains(err.Error(), "denied"):
+	default:
+		return "", false
+	}
+
+	details := status.Status().Details
+	if details == nil || len(details.Causes) == 0 {
+		return "Provider rejected the request: " + status.Status().Message, true
+	}
+
+	causes := make([]string, 0, len(details.Causes))
+	for _, cause := range details.Causes {
+		if cause.Field == "" {
+			causes = append(causes, cause.Message)
+			continue
+		}
+		causes = append(causes, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+	}
+	return fmt.Sprintf("Provider rejected %s %s: %s", details.Kind, details.Name, strings.Join(causes, "; ")), true
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	// secretRequeueInterval is how often an Application waiting for its
+	// database secret is checked again.
+	secretRequeueInterval = 10 * time.Second
+	// secretWaitTimeout is how long an Application waits for its database
+	// secret before it is marked as failed.
+	secretWaitTimeout = 10 * time.Minute
+	// secretFailedRequeueInterval is how often an Application that gave up
+	// waiting for its database secret is still checked again, so that it
+	// recovers once the secret is created.
+	secretFailedRequeueInterval = time.Minute
+)
+
+// waitForSecret marks app as waiting for its missing database secret. The
+// secret is often created right after the Application, so this is not an
+// error. Once the wait exceeds secretWaitTimeout the Application is marked
+// as failed.
+func (r *ApplicationReconciler) waitForSecret(ctx context.Context, app *apisv1alpha1.Application) (ctrl.Result, error) {
+	name := app.Spec.DatabaseSecretRef.Name
+	meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               apisv1alpha1.ConditionWaitingForSecret,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: app.Generation,
+		Reason:             "SecretNotFound",
+		Message:            fmt.Sprintf("Secret %s/%s does not exist", app.Namespace, name),
+	})
+
+	waiting := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionWaitingForSecret)
+	if since := waiting.LastTransitionTime.Time; time.Since(since) > secretWaitTimeout {
+		if err := r.setFailed(ctx, app, "SecretNotFound",
+			fmt.Sprintf("Secret %s/%s was not created within %s", app.Namespace, name, secretWaitTimeout)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: secretFailedRequeueInterval}, nil
+	}
+
+	log.FromContext(ctx).V(1).Info("Database secret does not exist yet", "secret", name)
+	if err := r.setPending(ctx, app, "WaitingForSecret",
+		fmt.Sprintf("Waiting for secret %s/%s to be created", app.Namespace, name)); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: secretRequeueInterval}, nil
+}
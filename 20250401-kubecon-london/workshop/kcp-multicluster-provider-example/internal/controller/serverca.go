@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	cnpgapiv1 "github.com/cloudnative-pg/cloudnative-pg/api/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// serverCAKey is the key of the CA certificate in the CNPG CA secret and in
+// the Secret it is propagated to.
+const serverCAKey = "ca.crt"
+
+// ServerCASecretName returns the name of the Secret in the Application's
+// namespace that holds the CA of its database server certificate.
+func ServerCASecretName(app *apisv1alpha1.Application) string {
+	return app.Name + "-ca"
+}
+
+// propagateServerCA copies the CA that signed the server certificate of
+// dbCluster into the Application's namespace, so that consumers can verify
+// the database. It is called on every reconcile, which picks up CA
+// rotations. A CA that CNPG has not issued yet is skipped.
+func (r *ApplicationReconciler) propagateServerCA(
+	ctx context.Context, app *apisv1alpha1.Application, dbCluster *cnpgapiv1.Cluster, namespace string,
+) error {
+	name := dbCluster.Status.Certificates.ServerCASecret
+	if name == "" {
+		name = dbCluster.Name + "-ca"
+	}
+	var ca corev1.Secret
+	if err := r.ProviderClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &ca); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.FromContext(ctx).V(1).Info("Server CA secret does not exist yet", "secret", name)
+			return nil
+		}
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: app.Namespace, Name: ServerCASecretName(app)},
+	}
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if !bytes.Equal(secret.Data[serverCAKey], ca.Data[serverCAKey]) {
+			secret.Data = map[string][]byte{serverCAKey: ca.Data[serverCAKey]}
+		}
+		return controllerutil.SetControllerReference(app, secret, r.Client.Scheme())
+	})
+	if err != nil {
+		return err
+	}
+	if op != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("Propagated server CA", "secret", secret.Name, "operation", op)
+	}
+	return nil
+}
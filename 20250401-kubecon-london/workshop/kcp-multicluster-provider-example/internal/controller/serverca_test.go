@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Application Controller", func() {
+	Context("When propagating the database server CA", func() {
+		const resourceName = "server-ca-resource"
+		const providerNamespace = "server-ca"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		caKey := types.NamespacedName{Name: resourceName + "-ca", Namespace: "default"}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+			Expect(k8sClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Namespace: caKey.Namespace, Name: caKey.Name,
+			}})).To(Succeed())
+		})
+
+		It("should copy the CA next to the Application and follow rotations", func() {
+			providerCA := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: providerNamespace, Name: "pg-ca"},
+				Data:       map[string][]byte{"ca.crt": []byte("first CA"), "ca.key": []byte("private")},
+			}
+			providerClient := newProviderClient(providerNamespace, interceptor.Funcs{}, providerCA)
+			controllerReconciler := &ApplicationReconciler{
+				Client:            k8sClient,
+				Scheme:            k8sClient.Scheme(),
+				ProviderClient:    providerClient,
+				PropagateServerCA: true,
+			}
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, caKey, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{"ca.crt": []byte("first CA")}))
+			Expect(secret.OwnerReferences).To(HaveLen(1))
+			Expect(secret.OwnerReferences[0].Name).To(Equal(resourceName))
+
+			By("rotating the CA on the provider")
+			providerCA.Data["ca.crt"] = []byte("second CA")
+			Expect(providerClient.Update(ctx, providerCA)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, caKey, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{"ca.crt": []byte("second CA")}))
+		})
+	})
+})
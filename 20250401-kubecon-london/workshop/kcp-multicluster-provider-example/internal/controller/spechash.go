@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// ForceReconcileAnnotation forces a full provisioning pass whenever its value
+// changes, even if the spec hash is unchanged. Any value works, a timestamp
+// is the usual choice.
+const ForceReconcileAnnotation = "contrib.kcp.io/force-reconcile"
+
+// specHash returns a stable hash of the Application spec and of the inputs
+// of any hooks implementing HashedHook.
+func specHash(app *apisv1alpha1.Application, hooks []ReconcileHook) (string, error) {
+	data, err := json.Marshal(app.Spec)
+	if err != nil {
+		return "", err
+	}
+	for _, hook := range hooks {
+		if hashed, ok := hook.(HashedHook); ok {
+			if input := hashed.HashInput(app); input != "" {
+				data = append(append(data, '\n'), input...)
+			}
+		}
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// isUpToDate reports whether the Application was provisioned from a spec
+// with the given hash and force-reconcile value, is Ready and its Deployment
+// still exists on the provider, in which case provisioning can be skipped.
+func (r *ApplicationReconciler) isUpToDate(
+	ctx context.Context,
+	app *apisv1alpha1.Application,
+	namespace, hash string,
+) (bool, error) {
+	if app.Status.ObservedSpecHash != hash || !meta.IsStatusConditionTrue(app.Status.Conditions, apisv1alpha1.ConditionReady) {
+		return false, nil
+	}
+	if app.Annotations[ForceReconcileAnnotation] != app.Status.ObservedForceReconcile {
+		return false, nil
+	}
+
+	err := r.ProviderClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: r.Naming.Name(app)}, &appsv1.Deployment{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
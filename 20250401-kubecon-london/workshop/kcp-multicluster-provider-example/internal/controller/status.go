@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// ErrApplicationsNotServed is returned by DetectStatusSubresource if the
+// Application API is not served at all.
+var ErrApplicationsNotServed = fmt.Errorf("%s applications are not served", apisv1alpha1.GroupVersion)
+
+// DetectStatusSubresource reports whether the Application API is served with
+// a status subresource.
+func DetectStatusSubresource(dc discovery.DiscoveryInterface) (bool, error) {
+	resources, err := dc.ServerResourcesForGroupVersion(apisv1alpha1.GroupVersion.String())
+	if apierrors.IsNotFound(err) {
+		return false, ErrApplicationsNotServed
+	}
+	if err != nil {
+		return false, err
+	}
+
+	served := false
+	for _, resource := range resources.APIResources {
+		switch resource.Name {
+		case "applications":
+			served = true
+		case "applications/status":
+			return true, nil
+		}
+	}
+	if !served {
+		return false, ErrApplicationsNotServed
+	}
+	return false, nil
+}
+
+// status returns the writer for the Application status. Without a status
+// subresource the status is written together with the rest of the object.
+// With CoalesceStatusUpdates, updates that would not change the status are
+// skipped.
+func (r *ApplicationReconciler) status() client.SubResourceWriter {
+	var w client.SubResourceWriter = r.Client.Status()
+	if r.NoStatusSubresource {
+		w = mainResourceStatusWriter{r.Client}
+	}
+	if r.writtenStatus != nil {
+		w = &coalescingStatusWriter{SubResourceWriter: w, written: r.writtenStatus}
+	}
+	return w
+}
+
+// coalescingStatusWriter skips status updates of an Application that would
+// write the status last read or written in this reconcile.
+type coalescingStatusWriter struct {
+	client.SubResourceWriter
+
+	written *apisv1alpha1.ApplicationStatus
+}
+
+func (w *coalescingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	app, ok := obj.(*apisv1alpha1.Application)
+	if !ok {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+	if equality.Semantic.DeepEqual(app.Status, *w.written) {
+		statusWritesSkippedTotal.Inc()
+		return nil
+	}
+	if err := w.SubResourceWriter.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	app.Status.DeepCopyInto(w.written)
+	return nil
+}
+
+// mainResourceStatusWriter writes the status through the main resource, for
+// Application CRDs installed without a status subresource. The API server
+// bumps the generation on each write, after the conditions recorded the
+// generation they observed.
+type mainResourceStatusWriter struct {
+	client.Client
+}
+
+var _ client.SubResourceWriter = mainResourceStatusWriter{}
+
+func (w mainResourceStatusWriter) Create(context.Context, client.Object, client.Object, ...client.SubResourceCreateOption) error {
+	return fmt.Errorf("the status subresource is not enabled")
+}
+
+func (w mainResourceStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	updateOpts := (&client.SubResourceUpdateOptions{}).ApplyOptions(opts)
+	return w.Client.Update(ctx, obj, &updateOpts.UpdateOptions)
+}
+
+func (w mainResourceStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	patchOpts := (&client.SubResourcePatchOptions{}).ApplyOptions(opts)
+	return w.Client.Patch(ctx, obj, patch, &patchOpts.PatchOptions)
+}
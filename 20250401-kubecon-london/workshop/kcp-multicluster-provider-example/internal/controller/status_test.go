@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Status subresource", func() {
+	discoveryFor := func(resources ...string) *fakediscovery.FakeDiscovery {
+		list := &metav1.APIResourceList{GroupVersion: apisv1alpha1.GroupVersion.String()}
+		for _, name := range resources {
+			list.APIResources = append(list.APIResources, metav1.APIResource{Name: name})
+		}
+		return &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{list}}}
+	}
+
+	It("should detect whether the status subresource is served", func() {
+		enabled, err := DetectStatusSubresource(discoveryFor("applications", "applications/status"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled).To(BeTrue())
+
+		enabled, err = DetectStatusSubresource(discoveryFor("applications"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+
+		_, err = DetectStatusSubresource(discoveryFor())
+		Expect(err).To(MatchError(ErrApplicationsNotServed))
+	})
+
+	It("should fall back to full updates without a status subresource", func() {
+		ctx := context.Background()
+		app := &apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "no-status"}}
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(app).Build()
+
+		By("failing with the status subresource assumed")
+		r := &ApplicationReconciler{Client: c}
+		err := r.setFailed(ctx, app, "ProviderRejected", "rejected")
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+
+		By("writing the status through the main resource")
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(app), app)).To(Succeed())
+		r.NoStatusSubresource = true
+		Expect(r.setFailed(ctx, app, "ProviderRejected", "rejected")).To(Succeed())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(app), app)).To(Succeed())
+		Expect(app.Status.Status).To(Equal("Failed"))
+	})
+
+	It("should not reconcile again after its own status writes without a status subresource", func() {
+		ctx := context.Background()
+		app := &apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "no-status", Generation: 1}}
+		var updates []event.UpdateEvent
+		c := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(app).WithInterceptorFuncs(interceptor.Funcs{
+			// Like the API server, bump the generation on every write, as
+			// the status is part of the main resource.
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				old := &apisv1alpha1.Application{}
+				if err := c.Get(ctx, client.ObjectKeyFromObject(obj), old); err != nil {
+					return err
+				}
+				obj.SetGeneration(old.Generation + 1)
+				if err := c.Update(ctx, obj, opts...); err != nil {
+					return err
+				}
+				updates = append(updates, event.UpdateEvent{ObjectOld: old, ObjectNew: obj.DeepCopyObject().(client.Object)})
+				return nil
+			},
+		}).Build()
+		r := &ApplicationReconciler{Client: c, NoStatusSubresource: true}
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(app), app)).To(Succeed())
+		Expect(r.setPending(ctx, app, "DatabaseNotReady", "waiting")).To(Succeed())
+		Expect(r.setFailed(ctx, app, "ProviderRejected", "rejected")).To(Succeed())
+		Expect(updates).To(HaveLen(2))
+		Expect(app.Generation).To(BeEquivalentTo(3))
+		cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.ObservedGeneration).To(BeEquivalentTo(2), "the condition trails the generation by one")
+
+		reconciles := func(p predicate.Predicate) int {
+			n := 0
+			for _, e := range updates {
+				if p.Update(e) {
+					n++
+				}
+			}
+			return n
+		}
+		Expect(reconciles(ApplicationPredicate())).To(Equal(2))
+		Expect(reconciles(ApplicationPredicateWithoutStatusSubresource())).To(BeZero())
+
+		By("not mistaking the status writes for a spec change")
+		advanced, err := r.generationAdvanced(ctx, &apisv1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: "no-status", Generation: 1,
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(advanced).To(BeFalse())
+	})
+})
+
+var _ = Describe("Application Controller", func() {
+	Context("When status updates are coalesced", func() {
+		const resourceName = "coalesce-resource"
+		const providerNamespace = "coalesce"
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			createApplicationFixture(ctx, typeNamespacedName, providerNamespace)
+		})
+
+		AfterEach(func() {
+			deleteApplicationFixture(ctx, typeNamespacedName)
+		})
+
+		unreachable := func(context.Context, string, string) (net.Conn, error) {
+			return nil, stderrors.New("i/o timeout")
+		}
+
+		reconcileTwice := func(coalesce bool) int {
+			workspaceClient := &statusCountingClient{Client: k8sClient}
+			controllerReconciler := &ApplicationReconciler{
+				Client:                workspaceClient,
+				Scheme:                k8sClient.Scheme(),
+				ProviderClient:        newProviderClient(providerNamespace, interceptor.Funcs{}),
+				ConnectivityDialer:    unreachable,
+				CoalesceStatusUpdates: coalesce,
+			}
+			for range 2 {
+				_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			return workspaceClient.updates
+		}
+
+		It("should write the status on every reconcile without coalescing", func() {
+			Expect(reconcileTwice(false)).To(Equal(2))
+		})
+
+		It("should not write a status that did not change", func() {
+			Expect(reconcileTwice(true)).To(Equal(1))
+
+			app := &apisv1alpha1.Application{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, app)).To(Succeed())
+			Expect(app.Status.Status).To(Equal("Pending"))
+			cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal("DatabaseUnreachable"))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	actionCreated = "created"
+	actionUpdated = "updated"
+	actionNoOp    = "no-op"
+	actionFailed  = "failed"
+	actionObserve = "observed"
+)
+
+// reconcileSummary collects what a single reconcile did to the provider so
+// that it can be reported as one structured log line.
+type reconcileSummary struct {
+	action    string
+	namespace string
+	// cluster is the CNPG Cluster backing the Application's database, once
+	// it was resolved.
+	cluster string
+
+	// pending lists the provider objects an observe-only reconcile would
+	// have created.
+	pending []string
+}
+
+// record folds the result of a provider write into the summary. A creation
+// takes precedence over an update, which takes precedence over a no-op.
+func (s *reconcileSummary) record(op controllerutil.OperationResult) {
+	switch op {
+	case controllerutil.OperationResultCreated:
+		s.action = actionCreated
+	case controllerutil.OperationResultUpdated,
+		controllerutil.OperationResultUpdatedStatus,
+		controllerutil.OperationResultUpdatedStatusOnly:
+		if s.action != actionCreated {
+			s.action = actionUpdated
+		}
+	}
+}
+
+// observe records a provider object that is missing and would be created if
+// the reconciler were allowed to write.
+func (s *reconcileSummary) observe(kind string, key client.ObjectKey) {
+	s.action = actionObserve
+	s.pending = append(s.pending, fmt.Sprintf("%s %s", kind, key))
+}
+
+func (s *reconcileSummary) observedMessage() string {
+	if len(s.pending) == 0 {
+		return "Provider is up to date"
+	}
+	return "Would create " + strings.Join(s.pending, ", ")
+}
+
+// log reports the summary along with the Ready condition of app. The
+// condition fields are empty if it has none yet.
+func (s *reconcileSummary) log(log logr.Logger, app *apisv1alpha1.Application, duration time.Duration) {
+	var ready, readyReason string
+	if cond := meta.FindStatusCondition(app.Status.Conditions, apisv1alpha1.ConditionReady); cond != nil {
+		ready, readyReason = string(cond.Status), cond.Reason
+	}
+	log.Info("Reconcile summary",
+		"action", s.action,
+		"providerNamespace", s.namespace,
+		"cluster", s.cluster,
+		"generation", app.Generation,
+		"status", app.Status.Status,
+		"ready", ready,
+		"readyReason", readyReason,
+		"duration", duration.String(),
+	)
+}
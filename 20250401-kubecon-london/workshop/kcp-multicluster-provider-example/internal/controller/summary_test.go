@@ -0,0 +1,74 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Reconcile summary", func() {
+	It("should prefer created over updated over no-op", func() {
+		summary := &reconcileSummary{action: actionNoOp}
+		summary.record(controllerutil.OperationResultNone)
+		Expect(summary.action).To(Equal(actionNoOp))
+		summary.record(controllerutil.OperationResultUpdated)
+		Expect(summary.action).To(Equal(actionUpdated))
+		summary.record(controllerutil.OperationResultCreated)
+		summary.record(controllerutil.OperationResultUpdated)
+		Expect(summary.action).To(Equal(actionCreated))
+	})
+
+	It("should log a single line with all summary fields", func() {
+		var lines []string
+		logger := funcr.New(func(prefix, args string) {
+			lines = append(lines, args)
+		}, funcr.Options{})
+
+		app := &apisv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Generation: 3},
+			Status: apisv1alpha1.ApplicationStatus{
+				Status: "Ready",
+				Conditions: []metav1.Condition{{
+					Type:   apisv1alpha1.ConditionReady,
+					Status: metav1.ConditionTrue,
+					Reason: "Provisioned",
+				}},
+			},
+		}
+		summary := &reconcileSummary{action: actionCreated, namespace: "root:org", cluster: "pg"}
+		summary.log(logger, app, 2*time.Second)
+
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring(`"msg"="Reconcile summary"`))
+		Expect(lines[0]).To(ContainSubstring(`"action"="created"`))
+		Expect(lines[0]).To(ContainSubstring(`"providerNamespace"="root:org"`))
+		Expect(lines[0]).To(ContainSubstring(`"generation"=3`))
+		Expect(lines[0]).To(ContainSubstring(`"cluster"="pg"`))
+		Expect(lines[0]).To(ContainSubstring(`"status"="Ready"`))
+		Expect(lines[0]).To(ContainSubstring(`"ready"="True"`))
+		Expect(lines[0]).To(ContainSubstring(`"readyReason"="Provisioned"`))
+		Expect(lines[0]).To(ContainSubstring(`"duration"="2s"`))
+	})
+})
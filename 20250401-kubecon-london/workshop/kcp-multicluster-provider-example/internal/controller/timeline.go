@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// conditionMilestones maps the conditions whose transition to True is a
+// milestone to that milestone.
+var conditionMilestones = []struct {
+	condition string
+	milestone string
+}{
+	{condition: apisv1alpha1.ConditionClusterReady, milestone: apisv1alpha1.MilestoneClusterReady},
+	{condition: apisv1alpha1.ConditionReady, milestone: apisv1alpha1.MilestoneReady},
+}
+
+// recordMilestone appends milestone to the timeline of app and drops the
+// oldest milestones beyond MaxTimelineEvents.
+func recordMilestone(app *apisv1alpha1.Application, milestone string, now metav1.Time) {
+	app.Status.Timeline = append(app.Status.Timeline, apisv1alpha1.TimelineEvent{Milestone: milestone, Time: now})
+	if excess := len(app.Status.Timeline) - apisv1alpha1.MaxTimelineEvents; excess > 0 {
+		app.Status.Timeline = app.Status.Timeline[excess:]
+	}
+}
+
+// recordConditionMilestones records a milestone for every condition of
+// conditionMilestones that turned True since before.
+func recordConditionMilestones(app *apisv1alpha1.Application, before []metav1.Condition, now metav1.Time) {
+	for _, m := range conditionMilestones {
+		if !meta.IsStatusConditionTrue(before, m.condition) && meta.IsStatusConditionTrue(app.Status.Conditions, m.condition) {
+			recordMilestone(app, m.milestone, now)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Timeline", func() {
+	It("should keep only the most recent milestones", func() {
+		app := &apisv1alpha1.Application{}
+		for range apisv1alpha1.MaxTimelineEvents + 3 {
+			recordMilestone(app, apisv1alpha1.MilestoneReady, metav1.Now())
+		}
+		recordMilestone(app, apisv1alpha1.MilestoneClusterReady, metav1.Now())
+
+		Expect(app.Status.Timeline).To(HaveLen(apisv1alpha1.MaxTimelineEvents))
+		Expect(app.Status.Timeline[apisv1alpha1.MaxTimelineEvents-1].Milestone).To(Equal(apisv1alpha1.MilestoneClusterReady))
+	})
+
+	It("should only record conditions turning True", func() {
+		app := &apisv1alpha1.Application{}
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type: apisv1alpha1.ConditionReady, Status: metav1.ConditionTrue, Reason: "Provisioned",
+		})
+		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+			Type: apisv1alpha1.ConditionClusterReady, Status: metav1.ConditionFalse, Reason: "ClusterIsNotReady",
+		})
+
+		recordConditionMilestones(app, nil, metav1.Now())
+		Expect(app.Status.Timeline).To(HaveLen(1))
+		Expect(app.Status.Timeline[0].Milestone).To(Equal(apisv1alpha1.MilestoneReady))
+
+		recordConditionMilestones(app, app.Status.Conditions, metav1.Now())
+		Expect(app.Status.Timeline).To(HaveLen(1))
+	})
+})
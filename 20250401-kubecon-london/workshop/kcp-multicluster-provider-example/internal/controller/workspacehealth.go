@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+)
+
+var unhealthyWorkspaces = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "application_unhealthy_workspaces",
+	Help: "Number of workspaces whose recent Application reconciles all failed.",
+})
+
+func init() {
+	domainmetrics.MustRegister(unhealthyWorkspaces)
+}
+
+// WorkspaceHealth tracks which workspaces fail to reconcile. A workspace is
+// unhealthy once its reconciles failed threshold times in a row and healthy
+// again after the first success. Failing workspaces are only reported, they
+// are retried with backoff like any other failed reconcile and never stop
+// the reconciles of other workspaces.
+type WorkspaceHealth struct {
+	mu        sync.Mutex
+	threshold int
+	failures  map[string]int
+}
+
+// NewWorkspaceHealth returns a WorkspaceHealth reporting a workspace as
+// unhealthy after threshold consecutive failures.
+func NewWorkspaceHealth(threshold int) *WorkspaceHealth {
+	return &WorkspaceHealth{threshold: max(threshold, 1), failures: map[string]int{}}
+}
+
+// Observe records the result of a reconcile in workspace and logs when the
+// workspace becomes unhealthy or recovers.
+func (h *WorkspaceHealth) Observe(ctx context.Context, workspace string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	log := log.FromContext(ctx).WithValues("workspace", workspace)
+	failures := h.failures[workspace]
+	if err == nil {
+		if failures >= h.threshold {
+			unhealthyWorkspaces.Dec()
+			log.Info("Workspace recovered")
+		}
+		delete(h.failures, workspace)
+		return
+	}
+
+	failures++
+	h.failures[workspace] = failures
+	if failures == h.threshold {
+		unhealthyWorkspaces.Inc()
+		log.Error(err, "Workspace is unhealthy, its reconciles keep failing", "consecutiveFailures", failures)
+	}
+}
+
+// Unhealthy reports whether workspace is currently unhealthy.
+func (h *WorkspaceHealth) Unhealthy(workspace string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failures[workspace] >= h.threshold
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	stderrors "errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Workspace health", func() {
+	It("should report a failing workspace while others keep reconciling", func() {
+		before := testutil.ToFloat64(unhealthyWorkspaces)
+		health := NewWorkspaceHealth(3)
+		failure := stderrors.New("failed to get cluster")
+
+		for range 3 {
+			health.Observe(context.Background(), "root:broken", failure)
+			health.Observe(context.Background(), "root:healthy", nil)
+		}
+		Expect(health.Unhealthy("root:broken")).To(BeTrue())
+		Expect(health.Unhealthy("root:healthy")).To(BeFalse())
+		Expect(testutil.ToFloat64(unhealthyWorkspaces)).To(Equal(before + 1))
+
+		By("failing more often without counting the workspace twice")
+		health.Observe(context.Background(), "root:broken", failure)
+		Expect(testutil.ToFloat64(unhealthyWorkspaces)).To(Equal(before + 1))
+
+		By("recovering after a successful reconcile")
+		health.Observe(context.Background(), "root:broken", nil)
+		Expect(health.Unhealthy("root:broken")).To(BeFalse())
+		Expect(testutil.ToFloat64(unhealthyWorkspaces)).To(Equal(before))
+	})
+})
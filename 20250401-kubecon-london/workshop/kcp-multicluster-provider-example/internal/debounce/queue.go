@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debounce provides a workqueue that coalesces bursts of events for
+// the same key into a single reconcile.
+package debounce
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// queue delays every plain Add by the debounce window. The delaying queue
+// keeps a single pending entry per item, so all Adds for an item within the
+// window result in one reconcile. Requeues from reconcile results go through
+// AddAfter and AddRateLimited and are not delayed further.
+type queue[T comparable] struct {
+	workqueue.TypedRateLimitingInterface[T]
+	window time.Duration
+}
+
+func (q *queue[T]) Add(item T) {
+	q.TypedRateLimitingInterface.AddAfter(item, q.window)
+}
+
+// NewQueue returns a controller queue constructor that debounces events
+// within window. A non-positive window returns the default queue.
+func NewQueue[T comparable](window time.Duration) func(string, workqueue.TypedRateLimiter[T]) workqueue.TypedRateLimitingInterface[T] {
+	return func(controllerName string, rateLimiter workqueue.TypedRateLimiter[T]) workqueue.TypedRateLimitingInterface[T] {
+		q := workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[T]{
+			Name: controllerName,
+		})
+		if window <= 0 {
+			return q
+		}
+		return &queue[T]{TypedRateLimitingInterface: q, window: window}
+	}
+}
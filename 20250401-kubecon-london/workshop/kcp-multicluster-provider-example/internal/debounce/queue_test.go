@@ -0,0 +1,52 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debounce
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var _ = Describe("Debounced queue", func() {
+	It("should coalesce rapid adds within the window into a single item", func() {
+		q := NewQueue[string](100*time.Millisecond)("test", workqueue.DefaultTypedControllerRateLimiter[string]())
+		defer q.ShutDown()
+
+		for range 5 {
+			q.Add("default/app")
+		}
+		Expect(q.Len()).To(BeZero(), "adds should be held back for the window")
+
+		Eventually(q.Len).Should(Equal(1))
+		item, _ := q.Get()
+		Expect(item).To(Equal("default/app"))
+		q.Done(item)
+
+		Consistently(q.Len, 300*time.Millisecond).Should(BeZero())
+	})
+
+	It("should not delay adds without a window", func() {
+		q := NewQueue[string](0)("test", workqueue.DefaultTypedControllerRateLimiter[string]())
+		defer q.ShutDown()
+
+		q.Add("default/app")
+		Expect(q.Len()).To(Equal(1))
+	})
+})
@@ -0,0 +1,173 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package domainmetrics keeps track of the controller's own metrics, as
+// opposed to the ones controller-runtime exposes, and renders a Grafana
+// dashboard from them.
+package domainmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	mu         sync.Mutex
+	collectors []prometheus.Collector
+)
+
+// MustRegister registers cs with the controller-runtime metrics registry and
+// adds them to the dashboard.
+func MustRegister(cs ...prometheus.Collector) {
+	metrics.Registry.MustRegister(cs...)
+
+	mu.Lock()
+	defer mu.Unlock()
+	collectors = append(collectors, cs...)
+}
+
+// Metric is a registered domain metric.
+type Metric struct {
+	Name string
+	Help string
+	Kind Kind
+}
+
+// Kind is the type of a Metric, which decides how it is graphed.
+type Kind string
+
+// The kinds of Metrics.
+const (
+	KindCounter   Kind = "counter"
+	KindGauge     Kind = "gauge"
+	KindHistogram Kind = "histogram"
+)
+
+// descPattern extracts the name and help of a prometheus.Desc, which are not
+// exposed other than through its String method.
+var descPattern = regexp.MustCompile(`fqName: ("(?:[^"\\]|\\.)*"), help: ("(?:[^"\\]|\\.)*")`)
+
+// Metrics returns the registered domain metrics in registration order.
+func Metrics() ([]Metric, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var result []Metric
+	for _, c := range collectors {
+		kind := kindOf(c)
+		for _, desc := range describe(c) {
+			m := descPattern.FindStringSubmatch(desc.String())
+			if m == nil {
+				return nil, fmt.Errorf("unable to parse metric description %s", desc)
+			}
+			name, err := strconv.Unquote(m[1])
+			if err != nil {
+				return nil, err
+			}
+			help, err := strconv.Unquote(m[2])
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, Metric{Name: name, Help: help, Kind: kind})
+		}
+	}
+	return result, nil
+}
+
+func describe(c prometheus.Collector) []*prometheus.Desc {
+	ch := make(chan *prometheus.Desc)
+	go func() {
+		c.Describe(ch)
+		close(ch)
+	}()
+	var descs []*prometheus.Desc
+	for desc := range ch {
+		descs = append(descs, desc)
+	}
+	return descs
+}
+
+func kindOf(c prometheus.Collector) Kind {
+	switch c.(type) {
+	case prometheus.Histogram, *prometheus.HistogramVec:
+		return KindHistogram
+	// A Gauge also satisfies the Counter interface, so it is checked first.
+	case prometheus.Gauge, *prometheus.GaugeVec:
+		return KindGauge
+	default:
+		return KindCounter
+	}
+}
+
+// expr returns the PromQL query graphing m.
+func (m Metric) expr() string {
+	switch m.Kind {
+	case KindHistogram:
+		return fmt.Sprintf("histogram_quantile(0.99, sum by (le) (rate(%s_bucket[5m])))", m.Name)
+	case KindGauge:
+		return m.Name
+	default:
+		return fmt.Sprintf("sum(rate(%s[5m]))", m.Name)
+	}
+}
+
+// Dashboard returns a Grafana dashboard with one panel per registered domain
+// metric. The Prometheus data source is chosen through a dashboard variable.
+func Dashboard(title string) ([]byte, error) {
+	ms, err := Metrics()
+	if err != nil {
+		return nil, err
+	}
+
+	datasource := map[string]string{"type": "prometheus", "uid": "${datasource}"}
+	panels := make([]map[string]any, 0, len(ms))
+	for i, m := range ms {
+		panels = append(panels, map[string]any{
+			"id":          i + 1,
+			"type":        "timeseries",
+			"title":       m.Name,
+			"description": m.Help,
+			"datasource":  datasource,
+			"gridPos":     map[string]int{"x": (i % 2) * 12, "y": (i / 2) * 8, "w": 12, "h": 8},
+			"targets": []map[string]any{{
+				"refId":      "A",
+				"datasource": datasource,
+				"expr":       m.expr(),
+			}},
+		})
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"title":         title,
+		"schemaVersion": 39,
+		"time":          map[string]string{"from": "now-6h", "to": "now"},
+		"refresh":       "30s",
+		"templating": map[string]any{
+			"list": []map[string]any{{
+				"name":  "datasource",
+				"type":  "datasource",
+				"query": "prometheus",
+			}},
+		},
+		"panels": panels,
+	}, "", "  ")
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainmetrics_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	_ "github.com/kcp-dev/multicluster-provider/examples/crd/internal/controller"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+	_ "github.com/kcp-dev/multicluster-provider/examples/crd/internal/leader"
+	_ "github.com/kcp-dev/multicluster-provider/examples/crd/internal/providerclient"
+	_ "github.com/kcp-dev/multicluster-provider/examples/crd/internal/supervisor"
+	_ "github.com/kcp-dev/multicluster-provider/examples/crd/internal/throttle"
+)
+
+var _ = Describe("Dashboard", func() {
+	type dashboard struct {
+		Panels []struct {
+			Title   string `json:"title"`
+			Targets []struct {
+				Expr string `json:"expr"`
+			} `json:"targets"`
+		} `json:"panels"`
+	}
+
+	It("should graph every registered domain metric", func() {
+		data, err := domainmetrics.Dashboard("Applications")
+		Expect(err).NotTo(HaveOccurred())
+		var d dashboard
+		Expect(json.Unmarshal(data, &d)).To(Succeed())
+
+		ms, err := domainmetrics.Metrics()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Panels).To(HaveLen(len(ms)))
+		for i, m := range ms {
+			Expect(d.Panels[i].Title).To(Equal(m.Name))
+			Expect(d.Panels[i].Targets).To(HaveLen(1))
+			Expect(d.Panels[i].Targets[0].Expr).To(ContainSubstring(m.Name))
+		}
+	})
+
+	It("should pick the query from the metric kind", func() {
+		ms, err := domainmetrics.Metrics()
+		Expect(err).NotTo(HaveOccurred())
+
+		kinds := map[string]domainmetrics.Kind{}
+		for _, m := range ms {
+			kinds[m.Name] = m.Kind
+		}
+		Expect(kinds).To(HaveKeyWithValue("application_reconcile_total", domainmetrics.KindCounter))
+		Expect(kinds).To(HaveKeyWithValue("application_reconcile_duration_seconds", domainmetrics.KindHistogram))
+		Expect(kinds).To(HaveKeyWithValue("provider_connection_healthy", domainmetrics.KindGauge))
+		Expect(kinds).To(HaveKeyWithValue("reconcile_effective_concurrency", domainmetrics.KindGauge))
+	})
+})
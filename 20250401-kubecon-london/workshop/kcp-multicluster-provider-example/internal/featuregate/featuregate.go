@@ -0,0 +1,119 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package featuregate provides a registry of feature gates for experimental
+// controller capabilities, configured through a single --feature-gates flag.
+package featuregate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Feature is the name of a feature gate.
+type Feature string
+
+// Stage describes the maturity of a feature.
+type Stage string
+
+const (
+	// Alpha features are experimental and disabled by default.
+	Alpha Stage = "Alpha"
+	// Beta features are well tested and usually enabled by default.
+	Beta Stage = "Beta"
+)
+
+// FeatureSpec describes a known feature gate.
+type FeatureSpec struct {
+	Default bool
+	Stage   Stage
+}
+
+// FeatureGate knows a fixed set of features and whether they are enabled. It
+// implements flag.Value; Set must only be called before the gates are read.
+type FeatureGate struct {
+	known   map[Feature]FeatureSpec
+	enabled map[Feature]bool
+}
+
+// New returns a FeatureGate knowing the given features.
+func New(known map[Feature]FeatureSpec) *FeatureGate {
+	return &FeatureGate{known: known, enabled: map[Feature]bool{}}
+}
+
+// Enabled reports whether f is enabled. Unknown features are disabled.
+func (g *FeatureGate) Enabled(f Feature) bool {
+	if enabled, ok := g.enabled[f]; ok {
+		return enabled
+	}
+	return g.known[f].Default
+}
+
+// Set parses a comma-separated list of key=bool pairs, e.g.
+// "FeatureA=true,FeatureB=false". Unknown features are rejected.
+func (g *FeatureGate) Set(value string) error {
+	overrides := map[Feature]bool{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, found := strings.Cut(pair, "=")
+		if !found {
+			return fmt.Errorf("missing bool value for feature gate %q", k)
+		}
+		f := Feature(strings.TrimSpace(k))
+		if _, ok := g.known[f]; !ok {
+			return fmt.Errorf("unknown feature gate %q", f)
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return fmt.Errorf("invalid value %q for feature gate %q: %w", v, f, err)
+		}
+		overrides[f] = enabled
+	}
+
+	for f, enabled := range overrides {
+		g.enabled[f] = enabled
+	}
+	return nil
+}
+
+// String returns the explicitly set gates in the format accepted by Set.
+func (g *FeatureGate) String() string {
+	if g == nil {
+		return ""
+	}
+	pairs := make([]string, 0, len(g.enabled))
+	for f, enabled := range g.enabled {
+		pairs = append(pairs, fmt.Sprintf("%s=%t", f, enabled))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// KnownFeatures returns a description of every known feature, suitable for
+// flag usage strings.
+func (g *FeatureGate) KnownFeatures() []string {
+	features := make([]string, 0, len(g.known))
+	for f, spec := range g.known {
+		features = append(features, fmt.Sprintf("%s=true|false (%s - default=%t)", f, spec.Stage, spec.Default))
+	}
+	sort.Strings(features)
+	return features
+}
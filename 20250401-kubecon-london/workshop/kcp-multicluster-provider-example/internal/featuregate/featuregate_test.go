@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featuregate
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FeatureGate", func() {
+	const (
+		alphaFeature Feature = "AlphaFeature"
+		betaFeature  Feature = "BetaFeature"
+	)
+
+	var gate *FeatureGate
+
+	BeforeEach(func() {
+		gate = New(map[Feature]FeatureSpec{
+			alphaFeature: {Default: false, Stage: Alpha},
+			betaFeature:  {Default: true, Stage: Beta},
+		})
+	})
+
+	It("should report default values", func() {
+		Expect(gate.Enabled(alphaFeature)).To(BeFalse())
+		Expect(gate.Enabled(betaFeature)).To(BeTrue())
+		Expect(gate.Enabled("Unknown")).To(BeFalse())
+	})
+
+	It("should parse overrides", func() {
+		Expect(gate.Set("AlphaFeature=true, BetaFeature=false")).To(Succeed())
+		Expect(gate.Enabled(alphaFeature)).To(BeTrue())
+		Expect(gate.Enabled(betaFeature)).To(BeFalse())
+		Expect(gate.String()).To(Equal("AlphaFeature=true,BetaFeature=false"))
+	})
+
+	It("should accept an empty value", func() {
+		Expect(gate.Set("")).To(Succeed())
+		Expect(gate.Enabled(betaFeature)).To(BeTrue())
+	})
+
+	It("should reject unknown gates", func() {
+		Expect(gate.Set("Unknown=true")).To(MatchError(ContainSubstring(`unknown feature gate "Unknown"`)))
+	})
+
+	It("should reject invalid values without applying any override", func() {
+		Expect(gate.Set("AlphaFeature=true,BetaFeature=maybe")).NotTo(Succeed())
+		Expect(gate.Set("AlphaFeature")).NotTo(Succeed())
+		Expect(gate.Enabled(alphaFeature)).To(BeFalse())
+	})
+
+	It("should describe the known features", func() {
+		Expect(gate.KnownFeatures()).To(Equal([]string{
+			"AlphaFeature=true|false (Alpha - default=false)",
+			"BetaFeature=true|false (Beta - default=true)",
+		}))
+	})
+})
@@ -0,0 +1,36 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featuregate
+
+const (
+	// ProviderQuotaCheck refuses to provision Applications whose provider
+	// objects would exceed the ResourceQuotas of the provider namespace.
+	ProviderQuotaCheck Feature = "ProviderQuotaCheck"
+
+	// SpecHashShortCircuit skips provisioning of Ready Applications whose
+	// spec did not change since it was last provisioned.
+	SpecHashShortCircuit Feature = "SpecHashShortCircuit"
+)
+
+var defaultFeatures = map[Feature]FeatureSpec{
+	ProviderQuotaCheck:   {Default: true, Stage: Beta},
+	SpecHashShortCircuit: {Default: true, Stage: Beta},
+}
+
+// DefaultFeatureGate is the feature gate of the controller, configured via
+// the --feature-gates flag.
+var DefaultFeatureGate = New(defaultFeatures)
@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory keeps an in-memory index of the Applications reconciled
+// across all workspaces.
+package inventory
+
+import (
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Record describes a single reconciled Application.
+type Record struct {
+	Workspace         string `json:"workspace"`
+	Namespace         string `json:"namespace"`
+	Name              string `json:"name"`
+	ProviderNamespace string `json:"providerNamespace"`
+	Phase             string `json:"phase"`
+	Endpoint          string `json:"endpoint"`
+	// Provisioned reports whether the Application holds provider objects.
+	// It is only used for counting and not part of the admin API.
+	Provisioned bool `json:"-"`
+}
+
+type key struct {
+	workspace string
+	name      types.NamespacedName
+}
+
+// Inventory is a concurrency-safe index of Records. A nil Inventory is valid
+// and records nothing.
+type Inventory struct {
+	mu      sync.RWMutex
+	records map[key]Record
+}
+
+// New returns an empty Inventory.
+func New() *Inventory {
+	return &Inventory{records: map[key]Record{}}
+}
+
+// Set adds or replaces the record for an Application.
+func (i *Inventory) Set(r Record) {
+	if i == nil {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.records[key{workspace: r.Workspace, name: types.NamespacedName{Namespace: r.Namespace, Name: r.Name}}] = r
+}
+
+// Delete removes the record for an Application.
+func (i *Inventory) Delete(workspace string, name types.NamespacedName) {
+	if i == nil {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.records, key{workspace: workspace, name: name})
+}
+
+// CountProvisioned returns the number of provisioned Applications.
+func (i *Inventory) CountProvisioned() int {
+	if i == nil {
+		return 0
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	n := 0
+	for _, r := range i.records {
+		if r.Provisioned {
+			n++
+		}
+	}
+	return n
+}
+
+// List returns all records sorted by workspace, namespace and name.
+func (i *Inventory) List() []Record {
+	if i == nil {
+		return nil
+	}
+	i.mu.RLock()
+	records := make([]Record, 0, len(i.records))
+	for _, r := range i.records {
+		records = append(records, r)
+	}
+	i.mu.RUnlock()
+
+	sort.Slice(records, func(a, b int) bool {
+		if records[a].Workspace != records[b].Workspace {
+			return records[a].Workspace < records[b].Workspace
+		}
+		if records[a].Namespace != records[b].Namespace {
+			return records[a].Namespace < records[b].Namespace
+		}
+		return records[a].Name < records[b].Name
+	})
+	return records
+}
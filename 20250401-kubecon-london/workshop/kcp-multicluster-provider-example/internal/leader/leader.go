@@ -0,0 +1,64 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+)
+
+var leaderGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "leader",
+	Help: "Whether this replica is the active leader (1) or a standby (0).",
+}, []string{"holder"})
+
+func init() {
+	domainmetrics.MustRegister(leaderGauge)
+}
+
+var _ manager.LeaderElectionRunnable = &Tracker{}
+
+// Tracker exposes the leadership of this replica through the leader gauge.
+// It needs leader election, so the manager only starts it once leadership
+// is acquired.
+type Tracker struct {
+	holder string
+}
+
+// NewTracker returns a Tracker for the replica identified by holder and
+// reports it as a standby until it is started.
+func NewTracker(holder string) *Tracker {
+	leaderGauge.WithLabelValues(holder).Set(0)
+	return &Tracker{holder: holder}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (t *Tracker) NeedLeaderElection() bool {
+	return true
+}
+
+// Start reports the replica as leader until ctx is done.
+func (t *Tracker) Start(ctx context.Context) error {
+	leaderGauge.WithLabelValues(t.holder).Set(1)
+	<-ctx.Done()
+	leaderGauge.WithLabelValues(t.holder).Set(0)
+	return nil
+}
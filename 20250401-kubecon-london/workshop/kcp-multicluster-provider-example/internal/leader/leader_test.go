@@ -0,0 +1,45 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Tracker", func() {
+	It("should reflect leadership acquisition", func() {
+		gauge := leaderGauge.WithLabelValues("replica-a")
+
+		tracker := NewTracker("replica-a")
+		Expect(testutil.ToFloat64(gauge)).To(BeZero())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- tracker.Start(ctx)
+		}()
+		Eventually(func() float64 { return testutil.ToFloat64(gauge) }).Should(Equal(float64(1)))
+
+		cancel()
+		Expect(<-done).To(Succeed())
+		Expect(testutil.ToFloat64(gauge)).To(BeZero())
+	})
+})
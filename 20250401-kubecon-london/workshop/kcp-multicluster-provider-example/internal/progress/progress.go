@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package progress detects a reconcile loop that stopped making progress.
+package progress
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Tracker records reconcile progress across all workers. It reports
+// unhealthy once reconciles are in flight but none completed within the
+// window, which indicates a wedged worker or a deadlock.
+type Tracker struct {
+	mu           sync.Mutex
+	window       time.Duration
+	inflight     int
+	lastProgress time.Time
+
+	now func() time.Time
+}
+
+// NewTracker returns a Tracker that fails its check after window without
+// progress. A non-positive window disables the check.
+func NewTracker(window time.Duration) *Tracker {
+	return &Tracker{window: window, lastProgress: time.Now(), now: time.Now}
+}
+
+// Start records the start of a reconcile. The returned function must be
+// called once the reconcile completed.
+func (t *Tracker) Start() (done func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.inflight == 0 {
+		// Idle time is not a lack of progress.
+		t.lastProgress = t.now()
+	}
+	t.inflight++
+
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.inflight--
+		t.lastProgress = t.now()
+	}
+}
+
+// Check implements healthz.Checker.
+func (t *Tracker) Check(_ *http.Request) error {
+	if t.window <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.inflight == 0 {
+		return nil
+	}
+	if stalled := t.now().Sub(t.lastProgress); stalled > t.window {
+		return fmt.Errorf("no reconcile completed for %s with %d in flight", stalled.Round(time.Second), t.inflight)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracker", func() {
+	var now time.Time
+	var tracker *Tracker
+
+	BeforeEach(func() {
+		now = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		tracker = NewTracker(time.Minute)
+		tracker.now = func() time.Time { return now }
+	})
+
+	It("should be healthy while idle", func() {
+		now = now.Add(time.Hour)
+		Expect(tracker.Check(nil)).To(Succeed())
+	})
+
+	It("should go unhealthy when progress stalls", func() {
+		done := tracker.Start()
+		now = now.Add(30 * time.Second)
+		Expect(tracker.Check(nil)).To(Succeed())
+
+		now = now.Add(time.Minute)
+		Expect(tracker.Check(nil)).To(MatchError(ContainSubstring("no reconcile completed")))
+
+		done()
+		Expect(tracker.Check(nil)).To(Succeed())
+	})
+
+	It("should count any completed reconcile as progress", func() {
+		stuck := tracker.Start()
+		defer stuck()
+
+		for range 3 {
+			now = now.Add(45 * time.Second)
+			tracker.Start()()
+			Expect(tracker.Check(nil)).To(Succeed())
+		}
+	})
+
+	It("should never fail when disabled", func() {
+		tracker.window = 0
+		tracker.Start()
+		now = now.Add(time.Hour)
+		Expect(tracker.Check(nil)).To(Succeed())
+	})
+})
@@ -0,0 +1,109 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerclient
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ConfigFunc returns the rest.Config of the provider, typically read again
+// from a kubeconfig on every call.
+type ConfigFunc func() (*rest.Config, error)
+
+// Direct returns a BuildFunc for a client that reads from and writes to the
+// provider API directly.
+func Direct(config ConfigFunc, options client.Options) BuildFunc {
+	return func() (client.Client, error) {
+		cfg, err := config()
+		if err != nil {
+			return nil, err
+		}
+		return client.New(cfg, options)
+	}
+}
+
+// Cached returns a BuildFunc for a client that serves reads from an informer
+// cache and writes to the provider API directly. The cache of each built
+// client runs until ctx is done or the client is replaced by a rebuild.
+//
+// Namespaces and Secrets are always read directly: the connection check and
+// the namespace checks must see the provider as it is, and caching every
+// Secret of the provider would be both expensive and needlessly broad.
+// Every other type read through the client requires list and watch
+// permissions on the provider.
+func Cached(ctx context.Context, config ConfigFunc, options client.Options) BuildFunc {
+	return func() (client.Client, error) {
+		cfg, err := config()
+		if err != nil {
+			return nil, err
+		}
+		reader, err := cache.New(cfg, cache.Options{Scheme: options.Scheme, Mapper: options.Mapper})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create provider cache: %w", err)
+		}
+
+		opts := options
+		opts.Cache = &client.CacheOptions{
+			Reader:     reader,
+			DisableFor: []client.Object{&corev1.Namespace{}, &corev1.Secret{}},
+		}
+		c, err := client.New(cfg, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		go func() {
+			if err := reader.Start(ctx); err != nil {
+				log.FromContext(ctx).WithName("provider-client").Error(err, "Provider cache stopped")
+			}
+		}()
+		return &cachedClient{Client: c, stop: cancel}, nil
+	}
+}
+
+// cachedClient is a client built by Cached.
+type cachedClient struct {
+	client.Client
+
+	stop context.CancelFunc
+}
+
+// Stop stops the cache of the client.
+func (c *cachedClient) Stop() {
+	c.stop()
+}
+
+// stopper is implemented by clients that hold resources, like an informer
+// cache, which must be released once the client is no longer used.
+type stopper interface {
+	Stop()
+}
+
+// release stops c if it holds resources.
+func release(c client.Client) {
+	if s, ok := c.(stopper); ok {
+		s.Stop()
+	}
+}
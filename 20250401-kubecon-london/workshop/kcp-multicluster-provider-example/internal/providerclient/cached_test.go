@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerclient
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// configMapReads counts the reads of ConfigMaps that reach the API server.
+type configMapReads struct {
+	http.RoundTripper
+	reads *atomic.Int64
+}
+
+func (t configMapReads) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet && strings.Contains(req.URL.Path, "/configmaps") {
+		t.reads.Add(1)
+	}
+	return t.RoundTripper.RoundTrip(req)
+}
+
+var _ = Describe("Provider client caching", func() {
+	const polls = 20
+
+	// pollReads builds a provider client with build over a counting
+	// transport, polls a ConfigMap like the status path does and returns
+	// the number of ConfigMap reads that reached the API server.
+	pollReads := func(ctx context.Context, build func(ConfigFunc, client.Options) BuildFunc) int64 {
+		var reads atomic.Int64
+		config := func() (*rest.Config, error) {
+			c := rest.CopyConfig(cfg)
+			c.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+				return configMapReads{RoundTripper: rt, reads: &reads}
+			}
+			return c, nil
+		}
+		c, err := New(build(config, client.Options{Scheme: clientgoscheme.Scheme}), 0)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() { release(c.get()) })
+
+		key := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "polled"}
+		for range polls {
+			Expect(c.Get(ctx, key, &corev1.ConfigMap{})).To(Succeed())
+		}
+
+		By("writing directly to the API server")
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, key, cm)).To(Succeed())
+		cm.Data = map[string]string{"polled": "true"}
+		Expect(c.Update(ctx, cm)).To(Succeed())
+		return reads.Load()
+	}
+
+	BeforeEach(func(ctx SpecContext) {
+		direct, err := client.New(cfg, client.Options{Scheme: clientgoscheme.Scheme})
+		Expect(err).NotTo(HaveOccurred())
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "polled"}}
+		Expect(direct.Create(ctx, cm)).To(Succeed())
+		DeferCleanup(func(ctx SpecContext) {
+			Expect(direct.Delete(ctx, cm)).To(Succeed())
+		})
+	})
+
+	It("should read every poll from the API server without caching", func(ctx SpecContext) {
+		Expect(pollReads(ctx, Direct)).To(BeNumerically(">", polls))
+	})
+
+	It("should serve the polls from the cache with caching", func(ctx SpecContext) {
+		cached := func(config ConfigFunc, options client.Options) BuildFunc {
+			return Cached(ctx, config, options)
+		}
+		// The cache lists and watches ConfigMaps instead.
+		Expect(pollReads(ctx, cached)).To(BeNumerically("<", polls/2))
+	})
+})
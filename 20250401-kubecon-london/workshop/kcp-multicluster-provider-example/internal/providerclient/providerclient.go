@@ -0,0 +1,261 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providerclient keeps the provider cluster client usable across
+// credential rotation.
+package providerclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+)
+
+// probeTimeout bounds a single connection check.
+const probeTimeout = 10 * time.Second
+
+var (
+	healthyGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "provider_connection_healthy",
+		Help: "Whether the last provider connection check succeeded (1) or not (0).",
+	})
+	rebuildsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "provider_client_rebuilds_total",
+		Help: "Total number of times the provider client was rebuilt after a failed connection check.",
+	})
+)
+
+func init() {
+	domainmetrics.MustRegister(healthyGauge, rebuildsTotal)
+}
+
+// BuildFunc builds a new provider client, typically from a kubeconfig that
+// is read again on every call.
+type BuildFunc func() (client.Client, error)
+
+// ProbeFunc verifies that c can talk to the provider.
+type ProbeFunc func(ctx context.Context, c client.Client) error
+
+// DefaultProbe gets the default Namespace. Any answer from the provider but
+// an authentication or transport error counts as healthy.
+func DefaultProbe(ctx context.Context, c client.Client) error {
+	err := c.Get(ctx, client.ObjectKey{Name: metav1.NamespaceDefault}, &corev1.Namespace{})
+	if err == nil || apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+		return nil
+	}
+	return err
+}
+
+var (
+	_ client.Client                  = &Client{}
+	_ manager.LeaderElectionRunnable = &Client{}
+)
+
+// Client is a client.Client talking to the provider. Started as a runnable,
+// it periodically checks its connection and rebuilds the underlying client
+// when the check fails, so that rotated credentials are picked up.
+type Client struct {
+	build    BuildFunc
+	probe    ProbeFunc
+	interval time.Duration
+
+	mu      sync.RWMutex
+	current client.Client
+	lastErr error
+}
+
+// New builds the initial provider client with build and returns a Client
+// checking it every interval. A non-positive interval disables the checks.
+func New(build BuildFunc, interval time.Duration) (*Client, error) {
+	current, err := build()
+	if err != nil {
+		return nil, err
+	}
+	healthyGauge.Set(1)
+	return &Client{build: build, probe: DefaultProbe, interval: interval, current: current}, nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Standby
+// replicas keep their connection healthy too.
+func (c *Client) NeedLeaderElection() bool {
+	return false
+}
+
+// Start checks the connection every interval until ctx is done.
+func (c *Client) Start(ctx context.Context) error {
+	if c.interval <= 0 {
+		<-ctx.Done()
+		return nil
+	}
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.check(ctx)
+		}
+	}
+}
+
+// check probes the current client and replaces it with a freshly built one
+// if the probe fails.
+func (c *Client) check(ctx context.Context) {
+	log := log.FromContext(ctx).WithName("provider-client")
+
+	err := c.probeWithTimeout(ctx, c.get())
+	if err == nil {
+		c.setHealth(nil)
+		return
+	}
+	log.Info("Provider connection check failed, rebuilding the client", "error", err.Error())
+
+	rebuilt, err := c.build()
+	if err != nil {
+		c.setHealth(fmt.Errorf("failed to rebuild provider client: %w", err))
+		return
+	}
+	if err := c.probeWithTimeout(ctx, rebuilt); err != nil {
+		release(rebuilt)
+		c.setHealth(fmt.Errorf("provider connection check failed after rebuild: %w", err))
+		return
+	}
+
+	c.mu.Lock()
+	previous := c.current
+	c.current = rebuilt
+	c.mu.Unlock()
+	release(previous)
+	rebuildsTotal.Inc()
+	c.setHealth(nil)
+	log.Info("Rebuilt provider client")
+}
+
+func (c *Client) probeWithTimeout(ctx context.Context, cl client.Client) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	return c.probe(ctx, cl)
+}
+
+func (c *Client) setHealth(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastErr = err
+	if err != nil {
+		healthyGauge.Set(0)
+	} else {
+		healthyGauge.Set(1)
+	}
+}
+
+// Check implements healthz.Checker and reports the result of the last
+// connection check.
+func (c *Client) Check(_ *http.Request) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.lastErr != nil {
+		return errors.Join(errors.New("provider connection unhealthy"), c.lastErr)
+	}
+	return nil
+}
+
+func (c *Client) get() client.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.current
+}
+
+// Get implements client.Client.
+func (c *Client) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.get().Get(ctx, key, obj, opts...)
+}
+
+// List implements client.Client.
+func (c *Client) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.get().List(ctx, list, opts...)
+}
+
+// Create implements client.Client.
+func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.get().Create(ctx, obj, opts...)
+}
+
+// Delete implements client.Client.
+func (c *Client) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.get().Delete(ctx, obj, opts...)
+}
+
+// Update implements client.Client.
+func (c *Client) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.get().Update(ctx, obj, opts...)
+}
+
+// Patch implements client.Client.
+func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.get().Patch(ctx, obj, patch, opts...)
+}
+
+// DeleteAllOf implements client.Client.
+func (c *Client) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.get().DeleteAllOf(ctx, obj, opts...)
+}
+
+// Status implements client.Client.
+func (c *Client) Status() client.SubResourceWriter {
+	return c.get().Status()
+}
+
+// SubResource implements client.Client.
+func (c *Client) SubResource(subResource string) client.SubResourceClient {
+	return c.get().SubResource(subResource)
+}
+
+// Scheme implements client.Client.
+func (c *Client) Scheme() *runtime.Scheme {
+	return c.get().Scheme()
+}
+
+// RESTMapper implements client.Client.
+func (c *Client) RESTMapper() meta.RESTMapper {
+	return c.get().RESTMapper()
+}
+
+// GroupVersionKindFor implements client.Client.
+func (c *Client) GroupVersionKindFor(obj runtime.Object) (schema.GroupVersionKind, error) {
+	return c.get().GroupVersionKindFor(obj)
+}
+
+// IsObjectNamespaced implements client.Client.
+func (c *Client) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	return c.get().IsObjectNamespaced(obj)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerclient
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Client", func() {
+	ctx := context.Background()
+
+	// expiredClient answers every request as Unauthorized, like a client
+	// whose token was rotated away.
+	expiredClient := func() client.Client {
+		return fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return apierrors.NewUnauthorized("token expired")
+			},
+		}).Build()
+	}
+	rotatedClient := func() client.Client {
+		return fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}},
+		).Build()
+	}
+
+	It("should rebuild the client when the credentials rotated", func() {
+		builds := 0
+		c, err := New(func() (client.Client, error) {
+			builds++
+			if builds == 1 {
+				return expiredClient(), nil
+			}
+			return rotatedClient(), nil
+		}, 0)
+		Expect(err).NotTo(HaveOccurred())
+		rebuilds := testutil.ToFloat64(rebuildsTotal)
+
+		Expect(c.Get(ctx, client.ObjectKey{Name: metav1.NamespaceDefault}, &corev1.Namespace{})).NotTo(Succeed())
+
+		c.check(ctx)
+
+		Expect(builds).To(Equal(2))
+		Expect(testutil.ToFloat64(rebuildsTotal)).To(Equal(rebuilds + 1))
+		Expect(testutil.ToFloat64(healthyGauge)).To(Equal(float64(1)))
+		Expect(c.Check(nil)).To(Succeed())
+		Expect(c.Get(ctx, client.ObjectKey{Name: metav1.NamespaceDefault}, &corev1.Namespace{})).To(Succeed())
+	})
+
+	It("should keep a healthy client", func() {
+		builds := 0
+		c, err := New(func() (client.Client, error) {
+			builds++
+			return rotatedClient(), nil
+		}, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		c.check(ctx)
+
+		Expect(builds).To(Equal(1))
+		Expect(c.Check(nil)).To(Succeed())
+	})
+
+	It("should report unhealthy when the client cannot be rebuilt", func() {
+		builds := 0
+		c, err := New(func() (client.Client, error) {
+			builds++
+			if builds == 1 {
+				return expiredClient(), nil
+			}
+			return nil, errors.New("kubeconfig not found")
+		}, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		c.check(ctx)
+
+		Expect(c.Check(nil)).To(MatchError(ContainSubstring("kubeconfig not found")))
+		Expect(testutil.ToFloat64(healthyGauge)).To(BeZero())
+	})
+
+	It("should treat authorization failures as healthy", func() {
+		forbidden := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, metav1.NamespaceDefault, errors.New("denied"))
+			},
+		}).Build()
+		Expect(DefaultProbe(ctx, forbidden)).To(Succeed())
+		Expect(DefaultProbe(ctx, expiredClient())).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resync triggers a reconcile of every known Application on SIGHUP.
+package resync
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	mcreconcile "github.com/multicluster-runtime/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/inventory"
+)
+
+var _ manager.LeaderElectionRunnable = &Trigger{}
+
+// Trigger reconciles all Applications of an Inventory whenever the process
+// receives SIGHUP. It enqueues them through the Source watched by the
+// controller, leaving the Applications themselves untouched, and reports them
+// as forced until they were reconciled, so that the controller runs a full
+// provisioning pass. Only the leader has a populated Inventory and a running
+// controller, so Trigger needs leader election.
+type Trigger struct {
+	inventory *inventory.Inventory
+	events    chan event.TypedGenericEvent[mcreconcile.Request]
+
+	lock   sync.Mutex
+	forced map[mcreconcile.Request]struct{}
+}
+
+// NewTrigger returns a Trigger for the Applications in inv.
+func NewTrigger(inv *inventory.Inventory) *Trigger {
+	return &Trigger{
+		inventory: inv,
+		events:    make(chan event.TypedGenericEvent[mcreconcile.Request]),
+		forced:    map[mcreconcile.Request]struct{}{},
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (t *Trigger) NeedLeaderElection() bool {
+	return true
+}
+
+// Source returns the source the controller watches to receive the resynced
+// Applications.
+func (t *Trigger) Source() source.TypedSource[mcreconcile.Request] {
+	return source.TypedChannel(t.events, handler.TypedFuncs[mcreconcile.Request, mcreconcile.Request]{
+		GenericFunc: func(_ context.Context, e event.TypedGenericEvent[mcreconcile.Request], q workqueue.TypedRateLimitingInterface[mcreconcile.Request]) {
+			q.Add(e.Object)
+		},
+	})
+}
+
+// Forced reports whether req was resynced and not reconciled since. It
+// clears the request, so it should be called once per reconcile.
+func (t *Trigger) Forced(req mcreconcile.Request) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	_, ok := t.forced[req]
+	delete(t.forced, req)
+	return ok
+}
+
+// Start resyncs on every SIGHUP until ctx is done.
+func (t *Trigger) Start(ctx context.Context) error {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-signals:
+			t.Resync(ctx)
+		}
+	}
+}
+
+// Resync enqueues a reconcile of every Application in the Inventory. It
+// returns early if ctx is done.
+func (t *Trigger) Resync(ctx context.Context) {
+	log := log.FromContext(ctx).WithName("resync")
+
+	records := t.inventory.List()
+	log.Info("Resyncing all Applications", "count", len(records))
+
+	for _, record := range records {
+		req := mcreconcile.Request{
+			ClusterName: record.Workspace,
+			Request:     reconcile.Request{NamespacedName: types.NamespacedName{Namespace: record.Namespace, Name: record.Name}},
+		}
+		t.lock.Lock()
+		t.forced[req] = struct{}{}
+		t.lock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case t.events <- event.TypedGenericEvent[mcreconcile.Request]{Object: req}:
+		}
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resync
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mcreconcile "github.com/multicluster-runtime/multicluster-runtime/pkg/reconcile"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/inventory"
+)
+
+var _ = Describe("Trigger", func() {
+	It("should enqueue every known Application on SIGHUP", func() {
+		inv := inventory.New()
+		inv.Set(inventory.Record{Workspace: "root:consumer", Namespace: "default", Name: "app"})
+		inv.Set(inventory.Record{Workspace: "root:other", Namespace: "default", Name: "app"})
+
+		trigger := NewTrigger(inv)
+		queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[mcreconcile.Request]())
+		defer queue.ShutDown()
+
+		// Keep SIGHUP from terminating the test binary until the Trigger has
+		// registered its own handler.
+		guard := make(chan os.Signal, 1)
+		signal.Notify(guard, syscall.SIGHUP)
+		defer signal.Stop(guard)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		Expect(trigger.Source().Start(ctx, queue)).To(Succeed())
+		go func() {
+			defer GinkgoRecover()
+			Expect(trigger.Start(ctx)).To(Succeed())
+		}()
+
+		Eventually(func() int {
+			Expect(syscall.Kill(os.Getpid(), syscall.SIGHUP)).To(Succeed())
+			return queue.Len()
+		}).Should(Equal(2))
+
+		want := mcreconcile.Request{
+			ClusterName: "root:consumer",
+			Request:     reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "app"}},
+		}
+		var got []mcreconcile.Request
+		for range 2 {
+			req, _ := queue.Get()
+			got = append(got, req)
+			queue.Done(req)
+		}
+		Expect(got).To(ContainElement(want))
+
+		By("forcing a full provisioning pass once")
+		Expect(trigger.Forced(want)).To(BeTrue())
+		Expect(trigger.Forced(want)).To(BeFalse())
+	})
+})
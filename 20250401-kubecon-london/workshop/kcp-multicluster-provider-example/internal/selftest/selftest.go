@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package selftest provisions and tears down a canary Application to verify
+// the whole provisioning pipeline end to end.
+package selftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+	"github.com/kcp-dev/multicluster-provider/examples/crd/pkg/applicationclient"
+)
+
+// Options configures the canary Application created by the self-test.
+type Options struct {
+	// Namespace is the workspace namespace the canary is created in.
+	Namespace string
+	// Name is the name of the canary Application.
+	Name string
+	// DatabaseRef is the provider Database the canary points at.
+	DatabaseRef string
+	// DatabaseSecretName is the secret holding the database credentials.
+	DatabaseSecretName string
+	// Timeout bounds how long to wait for the canary to become Ready.
+	Timeout time.Duration
+	// PollInterval is how often the canary status is checked.
+	PollInterval time.Duration
+	// ConnectAddress, if set, is the host:port a TCP connection is opened to
+	// once the canary is Ready, to verify that its endpoint is reachable.
+	ConnectAddress string
+}
+
+// Run creates the canary Application, waits for it to become Ready,
+// optionally connects to its endpoint and deletes it again. The canary is
+// deleted even if it never became Ready, and Run waits until the controller
+// finalized it, so that its provider objects are not left behind by a
+// process exiting right after Run.
+func Run(ctx context.Context, c client.Client, opts Options) error {
+	log := log.FromContext(ctx).WithValues("namespace", opts.Namespace, "name", opts.Name)
+	apps := applicationclient.New(c)
+
+	app := &apisv1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.Name,
+			Namespace: opts.Namespace,
+		},
+		Spec: apisv1alpha1.ApplicationSpec{
+			DatabaseRef: opts.DatabaseRef,
+			DatabaseSecretRef: corev1.SecretReference{
+				Name: opts.DatabaseSecretName,
+			},
+		},
+	}
+	key := client.ObjectKeyFromObject(app)
+
+	log.Info("Creating canary Application")
+	if err := apps.Create(ctx, app); err != nil {
+		return err
+	}
+
+	_, waitErr := apps.WaitForReady(ctx, key, opts.PollInterval, opts.Timeout)
+	if waitErr == nil && opts.ConnectAddress != "" {
+		log.Info("Connecting to the canary endpoint", "address", opts.ConnectAddress)
+		waitErr = connect(ctx, opts.ConnectAddress, opts.Timeout)
+	}
+
+	log.Info("Deleting canary Application")
+	if err := apps.Delete(ctx, key); err != nil {
+		return errors.Join(waitErr, err)
+	}
+	if err := apps.WaitForDeletion(ctx, key, opts.PollInterval, opts.Timeout); err != nil {
+		return errors.Join(waitErr, err)
+	}
+
+	if waitErr != nil {
+		return waitErr
+	}
+
+	log.Info("Self-test succeeded")
+	return nil
+}
+
+// connect opens and closes a TCP connection to address.
+func connect(ctx context.Context, address string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("canary endpoint %s is unreachable: %w", address, err)
+	}
+	return conn.Close()
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package selftest
+
+import (
+	"context"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Self-test", func() {
+	var scheme *runtime.Scheme
+
+	opts := Options{
+		Namespace:          "default",
+		Name:               "canary",
+		DatabaseRef:        "db",
+		DatabaseSecretName: "db-secret",
+		Timeout:            time.Second,
+		PollInterval:       10 * time.Millisecond,
+	}
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(apisv1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	It("should succeed and clean up once the canary becomes Ready", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				// Stand in for the controller marking the canary as Ready.
+				obj.(*apisv1alpha1.Application).Status.Status = "Ready"
+				return c.Create(ctx, obj, opts...)
+			},
+		}).Build()
+
+		Expect(Run(context.Background(), c, opts)).To(Succeed())
+
+		err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "canary"}, &apisv1alpha1.Application{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	// readyWithFinalizer stands in for the controller marking the canary as
+	// Ready and adding its finalizer.
+	readyWithFinalizer := interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			app := obj.(*apisv1alpha1.Application)
+			app.Status.Status = "Ready"
+			app.Finalizers = []string{"test"}
+			return c.Create(ctx, obj, opts...)
+		},
+	}
+
+	It("should wait for the controller to finalize the canary", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(readyWithFinalizer).Build()
+		key := client.ObjectKey{Namespace: "default", Name: "canary"}
+
+		done := make(chan error, 1)
+		go func() { done <- Run(context.Background(), c, opts) }()
+
+		By("not returning while the finalizer is in place")
+		Eventually(func(g Gomega) {
+			app := &apisv1alpha1.Application{}
+			g.Expect(c.Get(context.Background(), key, app)).To(Succeed())
+			g.Expect(app.DeletionTimestamp).NotTo(BeNil())
+		}).Should(Succeed())
+		Consistently(done, 100*time.Millisecond).ShouldNot(Receive())
+
+		By("returning once the finalizer is removed")
+		app := &apisv1alpha1.Application{}
+		Expect(c.Get(context.Background(), key, app)).To(Succeed())
+		app.Finalizers = nil
+		Expect(c.Update(context.Background(), app)).To(Succeed())
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should connect to the canary endpoint", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		accepted := make(chan struct{})
+		go func() {
+			if conn, err := listener.Accept(); err == nil {
+				_ = conn.Close()
+				close(accepted)
+			}
+		}()
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				obj.(*apisv1alpha1.Application).Status.Status = "Ready"
+				return c.Create(ctx, obj, opts...)
+			},
+		}).Build()
+		connectOpts := opts
+		connectOpts.ConnectAddress = listener.Addr().String()
+		Expect(Run(context.Background(), c, connectOpts)).To(Succeed())
+		Eventually(accepted).Should(BeClosed())
+
+		By("failing once the endpoint is unreachable")
+		Expect(listener.Close()).To(Succeed())
+		err = Run(context.Background(), c, connectOpts)
+		Expect(err).To(MatchError(ContainSubstring("unreachable")))
+		err = c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "canary"}, &apisv1alpha1.Application{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail and still clean up when the canary never becomes Ready", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		Expect(Run(context.Background(), c, opts)).NotTo(Succeed())
+
+		err := c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "canary"}, &apisv1alpha1.Application{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,116 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	mcmanager "github.com/multicluster-runtime/multicluster-runtime/pkg/manager"
+	"github.com/multicluster-runtime/multicluster-runtime/pkg/multicluster"
+)
+
+// RunnableProvider is a multicluster.Provider that engages clusters with a
+// manager while it runs, such as the kcp virtual workspace provider.
+type RunnableProvider interface {
+	multicluster.Provider
+	Run(ctx context.Context, mgr mcmanager.Manager) error
+}
+
+// Provider is a multicluster.Provider whose Run builds a fresh
+// RunnableProvider every time, so that it can be restarted by Run after a
+// failure. A provider that failed keeps its started caches and cannot be run
+// again. Get and IndexField are delegated to the instance of the current run,
+// and the field indexes are added to every new instance.
+type Provider struct {
+	newProvider func() (RunnableProvider, error)
+
+	lock    sync.RWMutex
+	current RunnableProvider
+	indexes []fieldIndex
+}
+
+type fieldIndex struct {
+	obj          client.Object
+	field        string
+	extractValue client.IndexerFunc
+}
+
+var _ multicluster.Provider = &Provider{}
+
+// NewProvider returns a Provider building its instances with newProvider.
+func NewProvider(newProvider func() (RunnableProvider, error)) *Provider {
+	return &Provider{newProvider: newProvider}
+}
+
+// Get returns the cluster from the instance of the current run.
+func (p *Provider) Get(ctx context.Context, clusterName string) (cluster.Cluster, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.current == nil {
+		return nil, fmt.Errorf("cluster %q is not available, the provider is not running", clusterName)
+	}
+	return p.current.Get(ctx, clusterName)
+}
+
+// IndexField records the index for future instances and adds it to the
+// instance of the current run.
+func (p *Provider) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.indexes = append(p.indexes, fieldIndex{obj: obj, field: field, extractValue: extractValue})
+	if p.current == nil {
+		return nil
+	}
+	return p.current.IndexField(ctx, obj, field, extractValue)
+}
+
+// Run builds a new instance, adds the recorded field indexes to it and runs
+// it until ctx is done or it fails. The clusters it engaged are released
+// when Run returns.
+func (p *Provider) Run(ctx context.Context, mgr mcmanager.Manager) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	instance, err := p.newProvider()
+	if err != nil {
+		return fmt.Errorf("failed to construct provider: %w", err)
+	}
+
+	p.lock.Lock()
+	for _, index := range p.indexes {
+		if err := instance.IndexField(ctx, index.obj, index.field, index.extractValue); err != nil {
+			p.lock.Unlock()
+			return fmt.Errorf("failed to index field %q: %w", index.field, err)
+		}
+	}
+	p.current = instance
+	p.lock.Unlock()
+
+	defer func() {
+		p.lock.Lock()
+		p.current = nil
+		p.lock.Unlock()
+	}()
+	return instance.Run(ctx, mgr)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	mcmanager "github.com/multicluster-runtime/multicluster-runtime/pkg/manager"
+)
+
+// fakeProvider fails its first run if failing is set and otherwise runs
+// until its context is done.
+type fakeProvider struct {
+	id      int
+	failing bool
+	runs    int
+	indexes []string
+}
+
+func (p *fakeProvider) Get(_ context.Context, clusterName string) (cluster.Cluster, error) {
+	return nil, fmt.Errorf("instance %d has no cluster %s", p.id, clusterName)
+}
+
+func (p *fakeProvider) IndexField(_ context.Context, _ client.Object, field string, _ client.IndexerFunc) error {
+	p.indexes = append(p.indexes, field)
+	return nil
+}
+
+func (p *fakeProvider) Run(ctx context.Context, _ mcmanager.Manager) error {
+	p.runs++
+	if p.failing {
+		return errors.New("informer failed")
+	}
+	<-ctx.Done()
+	return nil
+}
+
+var _ = Describe("Provider", func() {
+	opts := Options{
+		InitialBackoff:         time.Millisecond,
+		MaxBackoff:             5 * time.Millisecond,
+		MaxConsecutiveFailures: 3,
+		StableAfter:            time.Hour,
+	}
+
+	It("should run a fresh instance after a failure", func() {
+		var instances []*fakeProvider
+		provider := NewProvider(func() (RunnableProvider, error) {
+			instance := &fakeProvider{id: len(instances) + 1, failing: len(instances) == 0}
+			instances = append(instances, instance)
+			return instance, nil
+		})
+		noop := func(client.Object) []string { return nil }
+		Expect(provider.IndexField(context.Background(), &corev1.ConfigMap{}, "spec.owner", noop)).To(Succeed())
+
+		_, err := provider.Get(context.Background(), "root:a")
+		Expect(err).To(MatchError(ContainSubstring("the provider is not running")))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		done := make(chan error)
+		go func() {
+			done <- Run(ctx, "provider", func(ctx context.Context) error { return provider.Run(ctx, nil) }, opts)
+		}()
+
+		Eventually(func() error {
+			_, err := provider.Get(context.Background(), "root:a")
+			return err
+		}).Should(MatchError("instance 2 has no cluster root:a"))
+
+		cancel()
+		Expect(<-done).To(Succeed())
+		Expect(instances).To(HaveLen(2))
+		for _, instance := range instances {
+			Expect(instance.runs).To(Equal(1))
+			Expect(instance.indexes).To(Equal([]string{"spec.owner"}))
+		}
+	})
+
+	It("should add new indexes to the running instance", func() {
+		instance := &fakeProvider{id: 1}
+		provider := NewProvider(func() (RunnableProvider, error) { return instance, nil })
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		done := make(chan error)
+		go func() { done <- provider.Run(ctx, nil) }()
+
+		Eventually(func() error {
+			_, err := provider.Get(context.Background(), "root:a")
+			return err
+		}).Should(MatchError("instance 1 has no cluster root:a"))
+		noop := func(client.Object) []string { return nil }
+		Expect(provider.IndexField(ctx, &corev1.ConfigMap{}, "spec.owner", noop)).To(Succeed())
+
+		cancel()
+		Expect(<-done).To(Succeed())
+		Expect(instance.indexes).To(Equal([]string{"spec.owner"}))
+	})
+})
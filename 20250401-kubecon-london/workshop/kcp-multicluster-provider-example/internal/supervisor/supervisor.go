@@ -0,0 +1,106 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package supervisor keeps a long-running function such as the multicluster
+// provider running across transient failures.
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+)
+
+var (
+	restartsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "supervised_restarts_total",
+		Help: "Total number of times a supervised component failed and was restarted.",
+	}, []string{"component"})
+	runningGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "supervised_running",
+		Help: "Whether a supervised component is running (1) or backing off after a failure (0).",
+	}, []string{"component"})
+)
+
+func init() {
+	domainmetrics.MustRegister(restartsTotal, runningGauge)
+}
+
+// Options configure how a failing function is restarted.
+type Options struct {
+	// InitialBackoff is the delay before the first restart. It doubles with
+	// every consecutive failure up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// MaxConsecutiveFailures is how many failures in a row are tolerated
+	// before Run gives up. A run that lasted StableAfter resets the count.
+	MaxConsecutiveFailures int
+	StableAfter            time.Duration
+}
+
+// DefaultOptions are the Options used for the multicluster provider.
+var DefaultOptions = Options{
+	InitialBackoff:         time.Second,
+	MaxBackoff:             time.Minute,
+	MaxConsecutiveFailures: 5,
+	StableAfter:            5 * time.Minute,
+}
+
+// Run calls run until ctx is done, restarting it with exponential backoff
+// whenever it returns an error. It returns the last error once run failed
+// more than MaxConsecutiveFailures times in a row, which indicates a fatal
+// rather than a transient problem.
+func Run(ctx context.Context, component string, run func(context.Context) error, opts Options) error {
+	log := log.FromContext(ctx).WithName("supervisor").WithValues("component", component)
+	running := runningGauge.WithLabelValues(component)
+
+	failures := 0
+	backoff := opts.InitialBackoff
+	for {
+		started := time.Now()
+		running.Set(1)
+		err := run(ctx)
+		running.Set(0)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == nil {
+			err = fmt.Errorf("%s stopped unexpectedly", component)
+		}
+
+		if time.Since(started) >= opts.StableAfter {
+			failures, backoff = 0, opts.InitialBackoff
+		}
+		failures++
+		if failures > opts.MaxConsecutiveFailures {
+			return fmt.Errorf("%s failed %d times in a row: %w", component, failures, err)
+		}
+
+		restartsTotal.WithLabelValues(component).Inc()
+		log.Error(err, "Component failed, restarting", "backoff", backoff, "consecutiveFailures", failures)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, opts.MaxBackoff)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Run", func() {
+	opts := Options{
+		InitialBackoff:         time.Millisecond,
+		MaxBackoff:             5 * time.Millisecond,
+		MaxConsecutiveFailures: 3,
+		StableAfter:            time.Hour,
+	}
+
+	It("should keep running across transient failures", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		calls := 0
+		restarts := testutil.ToFloat64(restartsTotal.WithLabelValues("transient"))
+		done := make(chan error)
+		go func() {
+			done <- Run(ctx, "transient", func(ctx context.Context) error {
+				calls++
+				if calls <= 2 {
+					return errors.New("workspace team-a failed to engage")
+				}
+				<-ctx.Done()
+				return nil
+			}, opts)
+		}()
+
+		Eventually(func() float64 {
+			return testutil.ToFloat64(runningGauge.WithLabelValues("transient"))
+		}).Should(Equal(float64(1)))
+		Eventually(func() float64 {
+			return testutil.ToFloat64(restartsTotal.WithLabelValues("transient"))
+		}).Should(Equal(restarts + 2))
+
+		cancel()
+		Expect(<-done).To(Succeed())
+		Expect(calls).To(Equal(3))
+	})
+
+	It("should give up on a persistent failure", func() {
+		calls := 0
+		err := Run(context.Background(), "fatal", func(context.Context) error {
+			calls++
+			return errors.New("unauthorized")
+		}, opts)
+
+		Expect(err).To(MatchError(ContainSubstring("fatal failed 4 times in a row: unauthorized")))
+		Expect(calls).To(Equal(4))
+		Expect(testutil.ToFloat64(runningGauge.WithLabelValues("fatal"))).To(BeZero())
+	})
+
+	It("should reset the failure count after a stable run", func() {
+		stable := opts
+		stable.StableAfter = 0
+
+		calls := 0
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		err := Run(ctx, "stable", func(context.Context) error {
+			calls++
+			if calls == 10 {
+				cancel()
+			}
+			return errors.New("connection reset")
+		}, stable)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(Equal(10))
+	})
+})
@@ -0,0 +1,38 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kcp-dev/multicluster-provider/examples/crd/internal/domainmetrics"
+)
+
+var (
+	effectiveConcurrency = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "reconcile_effective_concurrency",
+		Help: "Number of Application reconciles currently allowed to run at once.",
+	})
+	throttledTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "provider_throttled_requests_total",
+		Help: "Total number of provider requests rejected with 429 Too Many Requests.",
+	})
+)
+
+func init() {
+	domainmetrics.MustRegister(effectiveConcurrency, throttledTotal)
+}
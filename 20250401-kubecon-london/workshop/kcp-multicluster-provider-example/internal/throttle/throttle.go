@@ -0,0 +1,269 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle adapts reconcile concurrency to back pressure from the
+// provider API server.
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultRetryAfter is how long reconciles are paused after a throttled
+// response that did not say when to retry.
+const defaultRetryAfter = time.Second
+
+// Throttle limits how many reconciles run at once. Every throttled response
+// from the provider halves the limit and pauses new reconciles for the
+// Retry-After the provider asked for. The limit grows back by one for every
+// recovery interval without throttling, up to the configured maximum.
+type Throttle struct {
+	mu          sync.Mutex
+	maxLimit    int
+	limit       int
+	inflight    int
+	recovery    time.Duration
+	lastChange  time.Time
+	pausedUntil time.Time
+	// changed is closed and replaced whenever a waiting reconcile might be
+	// able to proceed.
+	changed chan struct{}
+
+	now func() time.Time
+}
+
+// New returns a Throttle allowing up to maxConcurrency concurrent
+// reconciles, which recovers one slot per recovery interval once the
+// provider stops throttling.
+func New(maxConcurrency int, recovery time.Duration) *Throttle {
+	t := &Throttle{
+		maxLimit: maxConcurrency,
+		limit:    maxConcurrency,
+		recovery: recovery,
+		changed:  make(chan struct{}),
+		now:      time.Now,
+	}
+	t.lastChange = t.now()
+	effectiveConcurrency.Set(float64(maxConcurrency))
+	return t
+}
+
+// Limit returns the current effective concurrency.
+func (t *Throttle) Limit() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.regrow()
+	return t.limit
+}
+
+// Acquire blocks until a reconcile may start or ctx is done. The returned
+// function must be called once the reconcile completed.
+func (t *Throttle) Acquire(ctx context.Context) (release func(), err error) {
+	for {
+		t.mu.Lock()
+		t.regrow()
+		now := t.now()
+		if t.inflight < t.limit && !now.Before(t.pausedUntil) {
+			t.inflight++
+			t.mu.Unlock()
+			return t.release, nil
+		}
+		changed := t.changed
+		// Without a pause or a pending regrow, only a release can make room.
+		var wait time.Duration
+		switch {
+		case now.Before(t.pausedUntil):
+			wait = t.pausedUntil.Sub(now)
+		case t.limit < t.maxLimit && t.recovery > 0:
+			wait = t.lastChange.Add(t.recovery).Sub(now)
+		}
+		t.mu.Unlock()
+
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timeout = time.After(wait)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-changed:
+		case <-timeout:
+		}
+	}
+}
+
+func (t *Throttle) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inflight--
+	t.notify()
+}
+
+// Observe records the outcome of a provider request. Throttled responses
+// reduce the limit and pause new reconciles, everything else is ignored.
+func (t *Throttle) Observe(err error) {
+	if !apierrors.IsTooManyRequests(err) {
+		return
+	}
+	retryAfter := defaultRetryAfter
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+	throttledTotal.Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.limit = max(t.limit/2, 1)
+	t.lastChange = now
+	if until := now.Add(retryAfter); until.After(t.pausedUntil) {
+		t.pausedUntil = until
+	}
+	effectiveConcurrency.Set(float64(t.limit))
+}
+
+// regrow grows the limit back for every recovery interval that passed
+// since it last changed. t.mu must be held.
+func (t *Throttle) regrow() {
+	if t.limit >= t.maxLimit || t.recovery <= 0 {
+		return
+	}
+	now := t.now()
+	steps := int(now.Sub(t.lastChange) / t.recovery)
+	if steps == 0 {
+		return
+	}
+	t.limit = min(t.limit+steps, t.maxLimit)
+	t.lastChange = t.lastChange.Add(time.Duration(steps) * t.recovery)
+	effectiveConcurrency.Set(float64(t.limit))
+	t.notify()
+}
+
+// notify wakes up waiting reconciles. t.mu must be held.
+func (t *Throttle) notify() {
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+var _ client.Client = &Client{}
+
+// Client is a client.Client that reports the outcome of every request to a
+// Throttle.
+type Client struct {
+	client.Client
+
+	throttle *Throttle
+}
+
+// NewClient returns a Client reporting the requests issued through c to t.
+func NewClient(c client.Client, t *Throttle) *Client {
+	return &Client{Client: c, throttle: t}
+}
+
+// Get implements client.Client.
+func (c *Client) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.observe(c.Client.Get(ctx, key, obj, opts...))
+}
+
+// List implements client.Client.
+func (c *Client) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.observe(c.Client.List(ctx, list, opts...))
+}
+
+// Create implements client.Client.
+func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.observe(c.Client.Create(ctx, obj, opts...))
+}
+
+// Update implements client.Client.
+func (c *Client) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.observe(c.Client.Update(ctx, obj, opts...))
+}
+
+// Patch implements client.Client.
+func (c *Client) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.observe(c.Client.Patch(ctx, obj, patch, opts...))
+}
+
+// Delete implements client.Client.
+func (c *Client) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.observe(c.Client.Delete(ctx, obj, opts...))
+}
+
+// DeleteAllOf implements client.Client.
+func (c *Client) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.observe(c.Client.DeleteAllOf(ctx, obj, opts...))
+}
+
+// Status implements client.Client.
+func (c *Client) Status() client.SubResourceWriter {
+	return &subResourceWriter{SubResourceWriter: c.Client.Status(), parent: c}
+}
+
+// SubResource implements client.Client.
+func (c *Client) SubResource(subResource string) client.SubResourceClient {
+	sc := c.Client.SubResource(subResource)
+	return &subResourceClient{
+		subResourceWriter: subResourceWriter{SubResourceWriter: sc, parent: c},
+		reader:            sc,
+	}
+}
+
+func (c *Client) observe(err error) error {
+	c.throttle.Observe(err)
+	return err
+}
+
+// subResourceWriter reports the requests issued through a
+// client.SubResourceWriter to the Throttle of parent.
+type subResourceWriter struct {
+	client.SubResourceWriter
+
+	parent *Client
+}
+
+// Create implements client.SubResourceWriter.
+func (w *subResourceWriter) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return w.parent.observe(w.SubResourceWriter.Create(ctx, obj, subResource, opts...))
+}
+
+// Update implements client.SubResourceWriter.
+func (w *subResourceWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return w.parent.observe(w.SubResourceWriter.Update(ctx, obj, opts...))
+}
+
+// Patch implements client.SubResourceWriter.
+func (w *subResourceWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return w.parent.observe(w.SubResourceWriter.Patch(ctx, obj, patch, opts...))
+}
+
+// subResourceClient is a subResourceWriter that also reports the reads of
+// a client.SubResourceClient.
+type subResourceClient struct {
+	subResourceWriter
+
+	reader client.SubResourceReader
+}
+
+// Get implements client.SubResourceClient.
+func (c *subResourceClient) Get(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	return c.parent.observe(c.reader.Get(ctx, obj, subResource, opts...))
+}
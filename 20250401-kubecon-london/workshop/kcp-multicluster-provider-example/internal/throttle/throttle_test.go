@@ -0,0 +1,135 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Throttle", func() {
+	var now time.Time
+	var throttle *Throttle
+
+	BeforeEach(func() {
+		now = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+		throttle = New(4, time.Minute)
+		throttle.now = func() time.Time { return now }
+		throttle.lastChange = now
+	})
+
+	tryAcquire := func() (func(), error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		return throttle.Acquire(ctx)
+	}
+
+	It("should reduce concurrency while the provider throttles", func() {
+		var releases []func()
+		for range 4 {
+			release, err := tryAcquire()
+			Expect(err).NotTo(HaveOccurred())
+			releases = append(releases, release)
+		}
+		_, err := tryAcquire()
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+
+		By("receiving a 429 from the provider")
+		throttle.Observe(apierrors.NewTooManyRequests("slow down", 5))
+		Expect(throttle.Limit()).To(Equal(2))
+		Expect(testutil.ToFloat64(effectiveConcurrency)).To(Equal(float64(2)))
+
+		By("draining the in-flight reconciles")
+		for _, release := range releases {
+			release()
+		}
+		_, err = tryAcquire()
+		Expect(err).To(MatchError(context.DeadlineExceeded), "paused for Retry-After")
+
+		now = now.Add(5 * time.Second)
+		for range 2 {
+			_, err := tryAcquire()
+			Expect(err).NotTo(HaveOccurred())
+		}
+		_, err = tryAcquire()
+		Expect(err).To(MatchError(context.DeadlineExceeded), "limited to 2")
+	})
+
+	It("should recover once the provider stops throttling", func() {
+		throttle.Observe(apierrors.NewTooManyRequests("slow down", 0))
+		throttle.Observe(apierrors.NewTooManyRequests("slow down", 0))
+		Expect(throttle.Limit()).To(Equal(1))
+
+		now = now.Add(time.Minute)
+		Expect(throttle.Limit()).To(Equal(2))
+		now = now.Add(time.Hour)
+		Expect(throttle.Limit()).To(Equal(4))
+	})
+
+	It("should never go below one reconcile", func() {
+		for range 10 {
+			throttle.Observe(apierrors.NewTooManyRequests("slow down", 0))
+		}
+		Expect(throttle.Limit()).To(Equal(1))
+	})
+
+	It("should ignore other errors", func() {
+		throttle.Observe(apierrors.NewNotFound(corev1.Resource("namespaces"), "default"))
+		throttle.Observe(nil)
+		Expect(throttle.Limit()).To(Equal(4))
+	})
+
+	It("should observe the requests of a Client", func() {
+		c := NewClient(fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return apierrors.NewTooManyRequests("slow down", 1)
+			},
+		}).Build(), throttle)
+
+		err := c.Get(context.Background(), client.ObjectKey{Name: "default"}, &corev1.Namespace{})
+		Expect(apierrors.IsTooManyRequests(err)).To(BeTrue())
+		Expect(throttle.Limit()).To(Equal(2))
+	})
+
+	It("should observe the status and subresource requests of a Client", func() {
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		c := NewClient(fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(namespace).WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceUpdate: func(context.Context, client.Client, string, client.Object, ...client.SubResourceUpdateOption) error {
+				return apierrors.NewTooManyRequests("slow down", 1)
+			},
+		}).Build(), throttle)
+
+		err := c.Status().Update(context.Background(), namespace)
+		Expect(apierrors.IsTooManyRequests(err)).To(BeTrue())
+		Expect(throttle.Limit()).To(Equal(2))
+
+		err = c.SubResource("status").Update(context.Background(), namespace)
+		Expect(apierrors.IsTooManyRequests(err)).To(BeTrue())
+		Expect(throttle.Limit()).To(Equal(1))
+	})
+})
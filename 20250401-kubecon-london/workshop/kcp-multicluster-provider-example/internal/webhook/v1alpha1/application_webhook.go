@@ -0,0 +1,219 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+const (
+	// ProtectedAnnotation marks an Application as protected from deletion.
+	ProtectedAnnotation = "contrib.kcp.io/protected"
+	// ConfirmDeleteAnnotation allows deleting a protected Application.
+	ConfirmDeleteAnnotation = "contrib.kcp.io/confirm-delete"
+)
+
+// preCheckTimeout bounds the provider pre-check, so that a slow provider
+// does not hold up admission.
+const preCheckTimeout = 2 * time.Second
+
+// ProviderPreCheck checks whether an Application can be provisioned on the
+// provider. A non-empty reason means provisioning clearly cannot succeed. An
+// error means the check itself failed, and the Application is admitted.
+type ProviderPreCheck func(ctx context.Context, app *apisv1alpha1.Application) (reason string, err error)
+
+// nolint:unused
+// log is for logging in this package.
+var applicationlog = logf.Log.WithName("application-resource")
+
+// SetupApplicationWebhookWithManager registers the webhook for Application in the manager.
+func SetupApplicationWebhookWithManager(mgr ctrl.Manager, validator *ApplicationCustomValidator) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&apisv1alpha1.Application{}).
+		WithValidator(validator).
+		Complete()
+}
+
+// CompileNamePattern compiles a naming convention for Application names. The
+// pattern has to match the whole name.
+func CompileNamePattern(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid name pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
+
+// +kubebuilder:webhook:path=/validate-apis-contrib-kcp-io-v1alpha1-application,mutating=false,failurePolicy=fail,sideEffects=None,groups=apis.contrib.kcp.io,resources=applications,verbs=create;update;delete,versions=v1alpha1,name=vapplication-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ApplicationCustomValidator struct is responsible for validating the Application resource
+// when it is created, updated, or deleted.
+type ApplicationCustomValidator struct {
+	// NamePattern, if set, is the naming convention Application names must
+	// match.
+	NamePattern *regexp.Regexp
+	// PreCheck, if set, rejects new Applications that clearly cannot be
+	// provisioned on the provider. It fails open: a check that errors or
+	// times out admits the Application with a warning.
+	PreCheck ProviderPreCheck
+	// NameCollision, if set, rejects new Applications whose provider objects
+	// would get the same names as those of an existing Application. It
+	// returns the colliding Application and fails open like PreCheck.
+	NameCollision ProviderPreCheck
+	// RequiredLabels, if set, are the labels every Application must carry,
+	// e.g. for cost attribution.
+	RequiredLabels []string
+}
+
+var _ webhook.CustomValidator = &ApplicationCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Application.
+func (v *ApplicationCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	application, ok := obj.(*apisv1alpha1.Application)
+	if !ok {
+		return nil, fmt.Errorf("expected a Application object but got %T", obj)
+	}
+	applicationlog.Info("Validation for Application upon creation", "name", application.GetName())
+
+	if v.NamePattern != nil && !v.NamePattern.MatchString(application.Name) {
+		return nil, fmt.Errorf("application name %s does not match the naming convention %s",
+			application.Name, v.NamePattern.String())
+	}
+
+	if err := v.checkRequiredLabels(application); err != nil {
+		return nil, err
+	}
+
+	var warnings admission.Warnings
+	if v.NameCollision != nil {
+		existing, warning := runPreCheck(ctx, v.NameCollision, application, "name collision check")
+		if existing != "" {
+			return nil, fmt.Errorf("application %s would get the same provider object names as application %s",
+				application.Name, existing)
+		}
+		warnings = append(warnings, warning...)
+	}
+
+	if v.PreCheck != nil {
+		reason, warning := runPreCheck(ctx, v.PreCheck, application, "provider pre-check")
+		if reason != "" {
+			return nil, fmt.Errorf("application %s cannot be provisioned: %s", application.Name, reason)
+		}
+		warnings = append(warnings, warning...)
+	}
+
+	return warnings, nil
+}
+
+// runPreCheck runs check for application within preCheckTimeout. A check that
+// fails returns a warning instead of a reason.
+func runPreCheck(
+	ctx context.Context, check ProviderPreCheck, application *apisv1alpha1.Application, what string,
+) (string, admission.Warnings) {
+	ctx, cancel := context.WithTimeout(ctx, preCheckTimeout)
+	defer cancel()
+
+	reason, err := check(ctx, application)
+	if err != nil {
+		applicationlog.Info("Pre-check failed, admitting the Application", "check", what, "name", application.GetName(), "error", err.Error())
+		return "", admission.Warnings{what + " could not be completed, provisioning is checked asynchronously"}
+	}
+	return reason, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Application.
+func (v *ApplicationCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	application, ok := newObj.(*apisv1alpha1.Application)
+	if !ok {
+		return nil, fmt.Errorf("expected a Application object for the newObj but got %T", newObj)
+	}
+	oldApplication, ok := oldObj.(*apisv1alpha1.Application)
+	if !ok {
+		return nil, fmt.Errorf("expected a Application object for the oldObj but got %T", oldObj)
+	}
+	applicationlog.Info("Validation for Application upon update", "name", application.GetName())
+
+	// Only changes to the required labels are checked, so that Applications
+	// created before the labels were required can still get their finalizer
+	// and status written, and be deleted.
+	if application.DeletionTimestamp != nil || !v.requiredLabelsChanged(oldApplication, application) {
+		return nil, nil
+	}
+	if err := v.checkRequiredLabels(application); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// requiredLabelsChanged reports whether any of the required labels differs
+// between oldApplication and application.
+func (v *ApplicationCustomValidator) requiredLabelsChanged(oldApplication, application *apisv1alpha1.Application) bool {
+	for _, key := range v.RequiredLabels {
+		if oldApplication.Labels[key] != application.Labels[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRequiredLabels rejects application if it misses any of the required
+// labels or has them set to an empty value.
+func (v *ApplicationCustomValidator) checkRequiredLabels(application *apisv1alpha1.Application) error {
+	var missing []string
+	for _, key := range v.RequiredLabels {
+		if application.Labels[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("application %s is missing the required labels %s", application.Name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Application.
+// Applications annotated as protected can only be deleted once the deletion
+// has been confirmed with ConfirmDeleteAnnotation.
+func (v *ApplicationCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	application, ok := obj.(*apisv1alpha1.Application)
+	if !ok {
+		return nil, fmt.Errorf("expected a Application object but got %T", obj)
+	}
+	applicationlog.Info("Validation for Application upon deletion", "name", application.GetName())
+
+	if application.Annotations[ProtectedAnnotation] != "true" {
+		return nil, nil
+	}
+	if _, confirmed := application.Annotations[ConfirmDeleteAnnotation]; confirmed {
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("application %s is protected by the %s annotation, set the %s annotation to confirm the deletion",
+		application.Name, ProtectedAnnotation, ConfirmDeleteAnnotation)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application Webhook", func() {
+	var (
+		obj       *apisv1alpha1.Application
+		validator ApplicationCustomValidator
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		obj = &apisv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "app",
+				Namespace:   "default",
+				Annotations: map[string]string{},
+			},
+		}
+		validator = ApplicationCustomValidator{}
+	})
+
+	Context("When creating an Application under Validating Webhook", func() {
+		It("Should allow any name without a naming convention", func() {
+			obj.Name = "Whatever_Name"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should allow names matching the naming convention", func() {
+			pattern, err := CompileNamePattern(`team-[a-z]+-[a-z0-9-]+`)
+			Expect(err).NotTo(HaveOccurred())
+			validator.NamePattern = pattern
+
+			obj.Name = "team-billing-pgadmin"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny names not matching the naming convention", func() {
+			pattern, err := CompileNamePattern(`team-[a-z]+-[a-z0-9-]+`)
+			Expect(err).NotTo(HaveOccurred())
+			validator.NamePattern = pattern
+
+			obj.Name = "pgadmin"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("does not match the naming convention")))
+
+			By("requiring the whole name to match")
+			obj.Name = "x-team-billing-pgadmin"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should reject an invalid naming convention", func() {
+			_, err := CompileNamePattern(`team-[a-z`)
+			Expect(err).To(MatchError(ContainSubstring("invalid name pattern")))
+		})
+
+		It("Should deny an Application the provider pre-check rules out", func() {
+			validator.PreCheck = func(context.Context, *apisv1alpha1.Application) (string, error) {
+				return "provider namespace team-a does not exist", nil
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("provider namespace team-a does not exist")))
+		})
+
+		It("Should admit an Application the provider pre-check allows", func() {
+			validator.PreCheck = func(context.Context, *apisv1alpha1.Application) (string, error) {
+				return "", nil
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should fail open when the provider pre-check errors", func() {
+			validator.PreCheck = func(context.Context, *apisv1alpha1.Application) (string, error) {
+				return "", errors.New("connection refused")
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+
+		It("Should fail open when the provider pre-check times out", func() {
+			validator.PreCheck = func(ctx context.Context, _ *apisv1alpha1.Application) (string, error) {
+				<-ctx.Done()
+				return "", ctx.Err()
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+		})
+
+		It("Should deny an Application whose provider names collide", func() {
+			validator.NameCollision = func(_ context.Context, app *apisv1alpha1.Application) (string, error) {
+				if app.Name == "app" {
+					return "team-a/app", nil
+				}
+				return "", nil
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("same provider object names as application team-a/app")))
+
+			By("admitting a distinct name")
+			obj.Name = "other-app"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny an Application missing required labels", func() {
+			validator.RequiredLabels = []string{"team", "cost-center"}
+			obj.Labels = map[string]string{"team": "billing", "cost-center": ""}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("missing the required labels cost-center")))
+
+			By("admitting it once all required labels are set")
+			obj.Labels["cost-center"] = "cc-1234"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When updating an Application under Validating Webhook", func() {
+		It("Should deny removing a required label", func() {
+			validator.RequiredLabels = []string{"team"}
+			oldObj := obj.DeepCopy()
+			oldObj.Labels = map[string]string{"team": "billing"}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("missing the required labels team")))
+		})
+
+		It("Should allow updates of an Application created before the labels were required", func() {
+			validator.RequiredLabels = []string{"team"}
+			obj.Finalizers = []string{"finalizer.apis.contrib.kcp.io/no-no-no"}
+			oldObj := obj.DeepCopy()
+			oldObj.Finalizers = nil
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("allowing the finalizer to be removed once it is deleted")
+			oldObj = obj.DeepCopy()
+			oldObj.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			obj = oldObj.DeepCopy()
+			obj.Finalizers = nil
+			_, err = validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When deleting an Application under Validating Webhook", func() {
+		It("Should allow deleting an unprotected Application", func() {
+			_, err := validator.ValidateDelete(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny deleting a protected Application without confirmation", func() {
+			obj.Annotations[ProtectedAnnotation] = "true"
+			_, err := validator.ValidateDelete(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("is protected")))
+		})
+
+		It("Should allow deleting a protected Application with confirmation", func() {
+			obj.Annotations[ProtectedAnnotation] = "true"
+			obj.Annotations[ConfirmDeleteAnnotation] = "yes"
+			_, err := validator.ValidateDelete(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,165 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhookcert keeps the caBundle of webhook configurations in sync
+// with the CA of the serving certificate of the webhook server.
+package webhookcert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations;mutatingwebhookconfigurations,verbs=get;patch
+
+// CABundleInjector patches the caBundle of the configured webhook
+// configurations whenever the webhook certificate rotates, retrying failed
+// patches with exponential backoff. It implements manager.Runnable.
+type CABundleInjector struct {
+	// Client talks to the cluster holding the webhook configurations.
+	Client client.Client
+	// CAPath is the file holding the PEM encoded CA bundle.
+	CAPath string
+	// ValidatingWebhookConfigurations are the names of the
+	// ValidatingWebhookConfigurations to patch.
+	ValidatingWebhookConfigurations []string
+	// MutatingWebhookConfigurations are the names of the
+	// MutatingWebhookConfigurations to patch.
+	MutatingWebhookConfigurations []string
+	// InitialBackoff is the delay before a failed injection is retried. It
+	// doubles with every consecutive failure up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	rotated chan struct{}
+}
+
+// NewCABundleInjector returns a CABundleInjector. Call its OnRotation method
+// from the certificate watcher to re-inject the CA bundle.
+func NewCABundleInjector(c client.Client, caPath string, validating, mutating []string) *CABundleInjector {
+	return &CABundleInjector{
+		Client:                          c,
+		CAPath:                          caPath,
+		ValidatingWebhookConfigurations: validating,
+		MutatingWebhookConfigurations:   mutating,
+		InitialBackoff:                  time.Second,
+		MaxBackoff:                      time.Minute,
+		rotated:                         make(chan struct{}, 1),
+	}
+}
+
+// OnRotation signals that the webhook certificate changed. It never blocks,
+// so it is safe to register as a certwatcher callback.
+func (i *CABundleInjector) OnRotation(tls.Certificate) {
+	select {
+	case i.rotated <- struct{}{}:
+	default:
+	}
+}
+
+// Start injects the current CA bundle and re-injects it on every rotation
+// until ctx is cancelled. A failed injection is retried after a backoff, or
+// right away if the certificate rotates in the meantime.
+func (i *CABundleInjector) Start(ctx context.Context) error {
+	log := log.FromContext(ctx).WithName("webhook-cabundle")
+
+	backoff := i.InitialBackoff
+	for {
+		var retry <-chan time.Time
+		if err := i.Inject(ctx); err != nil {
+			log.Error(err, "failed to inject webhook CA bundle", "backoff", backoff)
+			retry = time.After(backoff)
+			backoff = min(2*backoff, i.MaxBackoff)
+		} else {
+			backoff = i.InitialBackoff
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-retry:
+		case <-i.rotated:
+			log.Info("Webhook certificate rotated, updating CA bundle")
+		}
+	}
+}
+
+// Inject sets the caBundle of every webhook in the configured webhook
+// configurations to the content of CAPath. Empty names are skipped.
+func (i *CABundleInjector) Inject(ctx context.Context) error {
+	caBundle, err := os.ReadFile(i.CAPath)
+	if err != nil {
+		return fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+
+	for _, name := range i.ValidatingWebhookConfigurations {
+		if name == "" {
+			continue
+		}
+		cfg := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		if err := i.Client.Get(ctx, client.ObjectKey{Name: name}, cfg); err != nil {
+			return fmt.Errorf("failed to get ValidatingWebhookConfiguration %q: %w", name, err)
+		}
+		patch := client.MergeFrom(cfg.DeepCopy())
+		changed := false
+		for idx := range cfg.Webhooks {
+			if !bytes.Equal(cfg.Webhooks[idx].ClientConfig.CABundle, caBundle) {
+				cfg.Webhooks[idx].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := i.Client.Patch(ctx, cfg, patch); err != nil {
+			return fmt.Errorf("failed to patch ValidatingWebhookConfiguration %q: %w", name, err)
+		}
+	}
+
+	for _, name := range i.MutatingWebhookConfigurations {
+		if name == "" {
+			continue
+		}
+		cfg := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		if err := i.Client.Get(ctx, client.ObjectKey{Name: name}, cfg); err != nil {
+			return fmt.Errorf("failed to get MutatingWebhookConfiguration %q: %w", name, err)
+		}
+		patch := client.MergeFrom(cfg.DeepCopy())
+		changed := false
+		for idx := range cfg.Webhooks {
+			if !bytes.Equal(cfg.Webhooks[idx].ClientConfig.CABundle, caBundle) {
+				cfg.Webhooks[idx].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := i.Client.Patch(ctx, cfg, patch); err != nil {
+			return fmt.Errorf("failed to patch MutatingWebhookConfiguration %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhookcert
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CA bundle injection", func() {
+	var (
+		c      client.Client
+		caPath string
+	)
+
+	BeforeEach(func() {
+		caPath = filepath.Join(GinkgoT().TempDir(), "ca.crt")
+		Expect(os.WriteFile(caPath, []byte("initial-ca"), 0o600)).To(Succeed())
+
+		c = fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+			&admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "validating"},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{
+					{Name: "vapplication.kb.io", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte("stale")}},
+				},
+			},
+			&admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "mutating"},
+				Webhooks: []admissionregistrationv1.MutatingWebhook{
+					{Name: "mapplication.kb.io", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte("stale")}},
+				},
+			},
+		).Build()
+	})
+
+	caBundles := func() (string, string) {
+		validating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		Expect(c.Get(context.Background(), client.ObjectKey{Name: "validating"}, validating)).To(Succeed())
+		mutating := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		Expect(c.Get(context.Background(), client.ObjectKey{Name: "mutating"}, mutating)).To(Succeed())
+		return string(validating.Webhooks[0].ClientConfig.CABundle), string(mutating.Webhooks[0].ClientConfig.CABundle)
+	}
+
+	It("should update the caBundle when the certificate rotates", func() {
+		injector := NewCABundleInjector(c, caPath, []string{"validating"}, []string{"mutating"})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			defer GinkgoRecover()
+			Expect(injector.Start(ctx)).To(Succeed())
+		}()
+
+		Eventually(func() []string {
+			v, m := caBundles()
+			return []string{v, m}
+		}).WithTimeout(5 * time.Second).Should(Equal([]string{"initial-ca", "initial-ca"}))
+
+		Expect(os.WriteFile(caPath, []byte("rotated-ca"), 0o600)).To(Succeed())
+		injector.OnRotation(tls.Certificate{})
+
+		Eventually(func() []string {
+			v, m := caBundles()
+			return []string{v, m}
+		}).WithTimeout(5 * time.Second).Should(Equal([]string{"rotated-ca", "rotated-ca"}))
+	})
+
+	It("should skip empty configuration names", func() {
+		injector := NewCABundleInjector(c, caPath, []string{"validating"}, []string{""})
+		Expect(injector.Inject(context.Background())).To(Succeed())
+
+		v, m := caBundles()
+		Expect(v).To(Equal("initial-ca"))
+		Expect(m).To(Equal("stale"))
+	})
+
+	It("should retry a failed injection", func() {
+		injector := NewCABundleInjector(c, caPath, []string{"validating", "late"}, nil)
+		injector.InitialBackoff = 10 * time.Millisecond
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			defer GinkgoRecover()
+			Expect(injector.Start(ctx)).To(Succeed())
+		}()
+
+		By("creating the missing configuration after the first attempt failed")
+		Eventually(func() string {
+			v, _ := caBundles()
+			return v
+		}).WithTimeout(5 * time.Second).Should(Equal("initial-ca"))
+		Expect(c.Create(context.Background(), &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "late"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{Name: "vlate.kb.io", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte("stale")}},
+			},
+		})).To(Succeed())
+
+		Eventually(func() string {
+			late := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+			Expect(c.Get(context.Background(), client.ObjectKey{Name: "late"}, late)).To(Succeed())
+			return string(late.Webhooks[0].ClientConfig.CABundle)
+		}).WithTimeout(5 * time.Second).Should(Equal("initial-ca"))
+	})
+})
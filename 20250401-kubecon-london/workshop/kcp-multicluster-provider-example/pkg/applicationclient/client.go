@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package applicationclient provides a typed helper for driving Applications
+// from Go programs, such as the self-test and integration tests.
+package applicationclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+// Client wraps a controller-runtime client with common Application
+// operations.
+type Client struct {
+	client client.Client
+}
+
+// New returns a Client using c, whose scheme must include the Application
+// types.
+func New(c client.Client) *Client {
+	return &Client{client: c}
+}
+
+// IsReady reports whether app has been provisioned.
+func IsReady(app *apisv1alpha1.Application) bool {
+	return app.Status.Status == "Ready" || meta.IsStatusConditionTrue(app.Status.Conditions, apisv1alpha1.ConditionReady)
+}
+
+// Create creates app.
+func (c *Client) Create(ctx context.Context, app *apisv1alpha1.Application) error {
+	if err := c.client.Create(ctx, app); err != nil {
+		return fmt.Errorf("failed to create Application %s: %w", client.ObjectKeyFromObject(app), err)
+	}
+	return nil
+}
+
+// Get returns the Application with the given key.
+func (c *Client) Get(ctx context.Context, key client.ObjectKey) (*apisv1alpha1.Application, error) {
+	app := &apisv1alpha1.Application{}
+	if err := c.client.Get(ctx, key, app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// Delete deletes the Application with the given key. A missing Application
+// is not an error.
+func (c *Client) Delete(ctx context.Context, key client.ObjectKey) error {
+	app := &apisv1alpha1.Application{}
+	app.Namespace, app.Name = key.Namespace, key.Name
+	if err := c.client.Delete(ctx, app); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete Application %s: %w", key, err)
+	}
+	return nil
+}
+
+// WaitForReady polls the Application with the given key every interval
+// until it is Ready or timeout expires, and returns it.
+func (c *Client) WaitForReady(ctx context.Context, key client.ObjectKey, interval, timeout time.Duration) (*apisv1alpha1.Application, error) {
+	var app *apisv1alpha1.Application
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true,
+		func(ctx context.Context) (bool, error) {
+			var err error
+			app, err = c.Get(ctx, key)
+			if err != nil {
+				return false, client.IgnoreNotFound(err)
+			}
+			return IsReady(app), nil
+		})
+	if err != nil {
+		return app, fmt.Errorf("Application %s did not become ready: %w", key, err)
+	}
+	return app, nil
+}
+
+// WaitForDeletion polls the Application with the given key every interval
+// until it no longer exists or timeout expires. A deleted Application only
+// goes away once the controller released its provider objects and removed
+// its finalizer.
+func (c *Client) WaitForDeletion(ctx context.Context, key client.ObjectKey, interval, timeout time.Duration) error {
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true,
+		func(ctx context.Context) (bool, error) {
+			_, err := c.Get(ctx, key)
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		})
+	if err != nil {
+		return fmt.Errorf("Application %s was not deleted: %w", key, err)
+	}
+	return nil
+}
+
+// GetConnectionSecret returns the database secret the Application with the
+// given key connects with. The secret lives in the Application's namespace
+// unless its reference names another one.
+func (c *Client) GetConnectionSecret(ctx context.Context, key client.ObjectKey) (*corev1.Secret, error) {
+	app, err := c.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := app.Spec.DatabaseSecretRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = app.Namespace
+	}
+	secret := &corev1.Secret{}
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get connection secret of Application %s: %w", key, err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationclient
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apisv1alpha1 "github.com/kcp-dev/multicluster-provider/examples/crd/api/v1alpha1"
+)
+
+var _ = Describe("Application client", func() {
+	var c client.WithWatch
+	var apps *Client
+	ctx := context.Background()
+	key := client.ObjectKey{Namespace: "default", Name: "pgadmin"}
+
+	newApplication := func() *apisv1alpha1.Application {
+		return &apisv1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Spec: apisv1alpha1.ApplicationSpec{
+				DatabaseRef:       "db",
+				DatabaseSecretRef: corev1.SecretReference{Name: "db-secret"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(apisv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "db-secret"},
+			Data:       map[string][]byte{"username": []byte("app")},
+		}).Build()
+		apps = New(c)
+	})
+
+	It("should create, get and delete Applications", func() {
+		Expect(apps.Create(ctx, newApplication())).To(Succeed())
+
+		app, err := apps.Get(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(app.Spec.DatabaseRef).To(Equal("db"))
+
+		Expect(apps.Delete(ctx, key)).To(Succeed())
+		_, err = apps.Get(ctx, key)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		By("ignoring an already deleted Application")
+		Expect(apps.Delete(ctx, key)).To(Succeed())
+	})
+
+	It("should wait for an Application to become ready", func() {
+		Expect(apps.Create(ctx, newApplication())).To(Succeed())
+
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(50 * time.Millisecond)
+			app := &apisv1alpha1.Application{}
+			Expect(c.Get(ctx, key, app)).To(Succeed())
+			app.Status.Status = "Ready"
+			Expect(c.Update(ctx, app)).To(Succeed())
+		}()
+
+		app, err := apps.WaitForReady(ctx, key, 10*time.Millisecond, 5*time.Second)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsReady(app)).To(BeTrue())
+	})
+
+	It("should time out waiting for an Application that never becomes ready", func() {
+		Expect(apps.Create(ctx, newApplication())).To(Succeed())
+
+		_, err := apps.WaitForReady(ctx, key, 10*time.Millisecond, 50*time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring("did not become ready")))
+	})
+
+	It("should wait for an Application to be deleted", func() {
+		app := newApplication()
+		app.Finalizers = []string{"test"}
+		Expect(apps.Create(ctx, app)).To(Succeed())
+		Expect(apps.Delete(ctx, key)).To(Succeed())
+
+		By("timing out while the finalizer is in place")
+		err := apps.WaitForDeletion(ctx, key, 10*time.Millisecond, 50*time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring("was not deleted")))
+
+		By("returning once the finalizer is removed")
+		Expect(c.Get(ctx, key, app)).To(Succeed())
+		app.Finalizers = nil
+		Expect(c.Update(ctx, app)).To(Succeed())
+		Expect(apps.WaitForDeletion(ctx, key, 10*time.Millisecond, time.Second)).To(Succeed())
+	})
+
+	It("should return the connection secret", func() {
+		Expect(apps.Create(ctx, newApplication())).To(Succeed())
+
+		secret, err := apps.GetConnectionSecret(ctx, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Data).To(HaveKeyWithValue("username", []byte("app")))
+	})
+})